@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+
+locals {
+  budgets = {
+    "Boodschappen" = "600"
+    "Kleding"      = "150"
+    "Vakantie"     = "250"
+  }
+}
+
+resource "firefly3_budget" "budget" {
+  for_each = local.budgets
+
+  name               = each.key
+  auto_budget_type   = "reset"
+  auto_budget_amount = each.value
+  auto_budget_period = "monthly"
+}
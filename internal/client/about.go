@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// About describes the Firefly III server.
+type About struct {
+	Version    string `json:"version"`
+	APIVersion string `json:"api_version"`
+	PHPVersion string `json:"php_version"`
+	OS         string `json:"os"`
+	Driver     string `json:"driver"`
+}
+
+type AboutSingle struct {
+	Data About `json:"data"`
+}
+
+// serverInfo caches the server version. It is shared by all copies of a
+// client, as user groups do not change the server.
+type serverInfo struct {
+	mu      sync.Mutex
+	version string
+}
+
+func (c *Client) GetAbout(ctx context.Context) (*About, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/about", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AboutSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.Data, nil
+}
+
+// GetCurrentUser returns the user the API key belongs to.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/about/user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	user := result.Data.Attributes
+	user.ID = result.Data.ID
+	return &user, nil
+}
+
+// ServerVersion returns the Firefly III version of the server. It is requested
+// once and cached, so resources can check it cheaply.
+func (c *Client) ServerVersion(ctx context.Context) (string, error) {
+	if c.server == nil {
+		about, err := c.GetAbout(ctx)
+		if err != nil {
+			return "", err
+		}
+		return about.Version, nil
+	}
+
+	c.server.mu.Lock()
+	defer c.server.mu.Unlock()
+
+	if c.server.version == "" {
+		about, err := c.GetAbout(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.server.version = about.Version
+	}
+
+	return c.server.version, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+)
+
+type Account struct {
+	ID                 string  `json:"id,omitempty"`
+	CreatedAt          string  `json:"created_at,omitempty"`
+	UpdatedAt          string  `json:"updated_at,omitempty"`
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	AccountRole        string  `json:"account_role,omitempty"`
+	Active             bool    `json:"active"`
+	Order              int32   `json:"order,omitempty"`
+	CurrencyID         string  `json:"currency_id,omitempty"`
+	CurrencyCode       string  `json:"currency_code,omitempty"`
+	CurrentBalance     string  `json:"current_balance,omitempty"`
+	IBAN               string  `json:"iban"`
+	BIC                string  `json:"bic"`
+	AccountNumber      string  `json:"account_number"`
+	OpeningBalance     *string `json:"opening_balance,omitempty"`
+	OpeningBalanceDate *string `json:"opening_balance_date,omitempty"`
+	VirtualBalance     *string `json:"virtual_balance,omitempty"`
+	IncludeNetWorth    bool    `json:"include_net_worth"`
+	CreditCardType     *string `json:"credit_card_type,omitempty"`
+	MonthlyPaymentDate *string `json:"monthly_payment_date,omitempty"`
+	LiabilityType      *string `json:"liability_type,omitempty"`
+	LiabilityDirection *string `json:"liability_direction,omitempty"`
+	Interest           *string `json:"interest,omitempty"`
+	InterestPeriod     *string `json:"interest_period,omitempty"`
+	Notes              string  `json:"notes"`
+}
+
+type AccountSingle struct {
+	Data AccountData `json:"data"`
+}
+
+type AccountData struct {
+	Type       string  `json:"type"`
+	ID         string  `json:"id"`
+	Attributes Account `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (a *Account) unescapeHTML() {
+	a.Name = html.UnescapeString(a.Name)
+	a.IBAN = html.UnescapeString(a.IBAN)
+	a.BIC = html.UnescapeString(a.BIC)
+	a.AccountNumber = html.UnescapeString(a.AccountNumber)
+	a.Notes = html.UnescapeString(a.Notes)
+}
+
+func (c *Client) CreateAccount(ctx context.Context, account *Account) (*Account, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/accounts", account)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdAccount := result.Data.Attributes
+	createdAccount.ID = result.Data.ID
+	createdAccount.unescapeHTML()
+	return &createdAccount, nil
+}
+
+// ListAccounts returns all accounts of accountType, or all accounts when
+// accountType is empty.
+func (c *Client) ListAccounts(ctx context.Context, accountType string) ([]Account, error) {
+	path := "/api/v1/accounts"
+	if accountType != "" {
+		path += "?type=" + url.QueryEscape(accountType)
+	}
+
+	dataList, err := listAll[AccountData](ctx, c, path)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, 0, len(dataList))
+	for _, data := range dataList {
+		account := data.Attributes
+		account.ID = data.ID
+		account.unescapeHTML()
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, id string) (*Account, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/accounts/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	account := result.Data.Attributes
+	account.ID = result.Data.ID
+	account.unescapeHTML()
+	return &account, nil
+}
+
+func (c *Client) UpdateAccount(ctx context.Context, id string, account *Account) (*Account, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/accounts/"+id, account)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AccountSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedAccount := result.Data.Attributes
+	updatedAccount.ID = result.Data.ID
+	updatedAccount.unescapeHTML()
+	return &updatedAccount, nil
+}
+
+func (c *Client) DeleteAccount(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/accounts/"+id, nil)
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+)
+
+// Attachment is a file attached to an account, bill, budget, piggy bank, tag
+// or transaction journal. The content is uploaded separately.
+type Attachment struct {
+	ID             string `json:"id,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	Filename       string `json:"filename"`
+	AttachableType string `json:"attachable_type"`
+	AttachableID   string `json:"attachable_id"`
+	Title          string `json:"title"`
+	Notes          string `json:"notes"`
+	MD5            string `json:"md5,omitempty"`
+	Mime           string `json:"mime,omitempty"`
+	Size           int64  `json:"size,omitempty"`
+}
+
+type AttachmentSingle struct {
+	Data AttachmentData `json:"data"`
+}
+
+type AttachmentData struct {
+	Type       string     `json:"type"`
+	ID         string     `json:"id"`
+	Attributes Attachment `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (a *Attachment) unescapeHTML() {
+	a.Filename = html.UnescapeString(a.Filename)
+	a.Title = html.UnescapeString(a.Title)
+	a.Notes = html.UnescapeString(a.Notes)
+}
+
+func (c *Client) CreateAttachment(ctx context.Context, attachment *Attachment) (*Attachment, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/attachments", attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AttachmentSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdAttachment := result.Data.Attributes
+	createdAttachment.ID = result.Data.ID
+	createdAttachment.unescapeHTML()
+	return &createdAttachment, nil
+}
+
+func (c *Client) GetAttachment(ctx context.Context, id string) (*Attachment, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/attachments/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AttachmentSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	attachment := result.Data.Attributes
+	attachment.ID = result.Data.ID
+	attachment.unescapeHTML()
+	return &attachment, nil
+}
+
+func (c *Client) UpdateAttachment(ctx context.Context, id string, attachment *Attachment) (*Attachment, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/attachments/"+id, attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AttachmentSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedAttachment := result.Data.Attributes
+	updatedAttachment.ID = result.Data.ID
+	updatedAttachment.unescapeHTML()
+	return &updatedAttachment, nil
+}
+
+// UploadAttachment replaces the content of an attachment.
+func (c *Client) UploadAttachment(ctx context.Context, id string, content io.Reader) error {
+	_, err := c.doRawRequest(ctx, http.MethodPost, "/api/v1/attachments/"+id+"/upload", "application/octet-stream", content)
+	return err
+}
+
+func (c *Client) DeleteAttachment(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/attachments/"+id, nil)
+	return err
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AvailableBudget is the amount available for budgeting in a period.
+type AvailableBudget struct {
+	ID           string `json:"id,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code,omitempty"`
+	Start        string `json:"start"`
+	End          string `json:"end"`
+}
+
+type AvailableBudgetSingle struct {
+	Data AvailableBudgetData `json:"data"`
+}
+
+type AvailableBudgetData struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id"`
+	Attributes AvailableBudget `json:"attributes"`
+}
+
+func (c *Client) CreateAvailableBudget(ctx context.Context, availableBudget *AvailableBudget) (*AvailableBudget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/available-budgets", availableBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AvailableBudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdAvailableBudget := result.Data.Attributes
+	createdAvailableBudget.ID = result.Data.ID
+	return &createdAvailableBudget, nil
+}
+
+func (c *Client) GetAvailableBudget(ctx context.Context, id string) (*AvailableBudget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/available-budgets/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AvailableBudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	availableBudget := result.Data.Attributes
+	availableBudget.ID = result.Data.ID
+	return &availableBudget, nil
+}
+
+func (c *Client) UpdateAvailableBudget(ctx context.Context, id string, availableBudget *AvailableBudget) (*AvailableBudget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/available-budgets/"+id, availableBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AvailableBudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedAvailableBudget := result.Data.Attributes
+	updatedAvailableBudget.ID = result.Data.ID
+	return &updatedAvailableBudget, nil
+}
+
+func (c *Client) DeleteAvailableBudget(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/available-budgets/"+id, nil)
+	return err
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type Bill struct {
+	ID               string  `json:"id,omitempty"`
+	CreatedAt        string  `json:"created_at,omitempty"`
+	UpdatedAt        string  `json:"updated_at,omitempty"`
+	Name             string  `json:"name"`
+	AmountMin        string  `json:"amount_min"`
+	AmountMax        string  `json:"amount_max"`
+	Date             string  `json:"date"`
+	EndDate          *string `json:"end_date"`
+	ExtensionDate    *string `json:"extension_date"`
+	RepeatFreq       string  `json:"repeat_freq"`
+	Skip             int32   `json:"skip"`
+	CurrencyCode     string  `json:"currency_code,omitempty"`
+	Active           bool    `json:"active"`
+	Notes            string  `json:"notes"`
+	ObjectGroupID    string  `json:"object_group_id,omitempty"`
+	ObjectGroupTitle string  `json:"object_group_title"`
+}
+
+type BillSingle struct {
+	Data BillData `json:"data"`
+}
+
+type BillData struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes Bill   `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (b *Bill) unescapeHTML() {
+	b.Name = html.UnescapeString(b.Name)
+	b.Notes = html.UnescapeString(b.Notes)
+	b.ObjectGroupTitle = html.UnescapeString(b.ObjectGroupTitle)
+}
+
+func (c *Client) CreateBill(ctx context.Context, bill *Bill) (*Bill, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/bills", bill)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BillSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdBill := result.Data.Attributes
+	createdBill.ID = result.Data.ID
+	createdBill.unescapeHTML()
+	return &createdBill, nil
+}
+
+func (c *Client) GetBill(ctx context.Context, id string) (*Bill, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/bills/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BillSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	bill := result.Data.Attributes
+	bill.ID = result.Data.ID
+	bill.unescapeHTML()
+	return &bill, nil
+}
+
+func (c *Client) UpdateBill(ctx context.Context, id string, bill *Bill) (*Bill, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/bills/"+id, bill)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BillSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedBill := result.Data.Attributes
+	updatedBill.ID = result.Data.ID
+	updatedBill.unescapeHTML()
+	return &updatedBill, nil
+}
+
+func (c *Client) DeleteBill(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/bills/"+id, nil)
+	return err
+}
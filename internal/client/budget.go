@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type Budget struct {
+	ID                     string `json:"id,omitempty"`
+	CreatedAt              string `json:"created_at,omitempty"`
+	UpdatedAt              string `json:"updated_at,omitempty"`
+	Name                   string `json:"name"`
+	Active                 bool   `json:"active"`
+	Order                  int32  `json:"order,omitempty"`
+	Notes                  string `json:"notes"`
+	AutoBudgetType         string `json:"auto_budget_type,omitempty"`
+	AutoBudgetCurrencyCode string `json:"auto_budget_currency_code,omitempty"`
+	AutoBudgetAmount       string `json:"auto_budget_amount,omitempty"`
+	AutoBudgetPeriod       string `json:"auto_budget_period,omitempty"`
+	// CurrencyCode is the auto-budget currency as reported by newer API
+	// versions, which no longer return auto_budget_currency_code.
+	CurrencyCode string `json:"currency_code,omitempty"`
+}
+
+type BudgetSingle struct {
+	Data BudgetData `json:"data"`
+}
+
+type BudgetData struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes Budget `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (b *Budget) unescapeHTML() {
+	b.Name = html.UnescapeString(b.Name)
+	b.Notes = html.UnescapeString(b.Notes)
+}
+
+// normalize fills fields that are reported differently across API versions
+func (b *Budget) normalize() {
+	if b.AutoBudgetCurrencyCode == "" {
+		b.AutoBudgetCurrencyCode = b.CurrencyCode
+	}
+	if b.AutoBudgetType == "" {
+		b.AutoBudgetType = "none"
+	}
+}
+
+func (c *Client) CreateBudget(ctx context.Context, budget *Budget) (*Budget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/budgets", budget)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdBudget := result.Data.Attributes
+	createdBudget.ID = result.Data.ID
+	createdBudget.unescapeHTML()
+	createdBudget.normalize()
+	return &createdBudget, nil
+}
+
+func (c *Client) GetBudget(ctx context.Context, id string) (*Budget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/budgets/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	budget := result.Data.Attributes
+	budget.ID = result.Data.ID
+	budget.unescapeHTML()
+	budget.normalize()
+	return &budget, nil
+}
+
+func (c *Client) UpdateBudget(ctx context.Context, id string, budget *Budget) (*Budget, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/budgets/"+id, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedBudget := result.Data.Attributes
+	updatedBudget.ID = result.Data.ID
+	updatedBudget.unescapeHTML()
+	updatedBudget.normalize()
+	return &updatedBudget, nil
+}
+
+func (c *Client) DeleteBudget(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/budgets/"+id, nil)
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type BudgetLimit struct {
+	ID           string `json:"id,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+	BudgetID     string `json:"budget_id,omitempty"`
+	Start        string `json:"start"`
+	End          string `json:"end"`
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currency_code,omitempty"`
+	Notes        string `json:"notes"`
+}
+
+type BudgetLimitSingle struct {
+	Data BudgetLimitData `json:"data"`
+}
+
+type BudgetLimitData struct {
+	Type       string      `json:"type"`
+	ID         string      `json:"id"`
+	Attributes BudgetLimit `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (bl *BudgetLimit) unescapeHTML() {
+	bl.Notes = html.UnescapeString(bl.Notes)
+}
+
+func budgetLimitsPath(budgetID string) string {
+	return "/api/v1/budgets/" + budgetID + "/limits"
+}
+
+func (c *Client) CreateBudgetLimit(ctx context.Context, budgetID string, budgetLimit *BudgetLimit) (*BudgetLimit, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, budgetLimitsPath(budgetID), budgetLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetLimitSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdBudgetLimit := result.Data.Attributes
+	createdBudgetLimit.ID = result.Data.ID
+	createdBudgetLimit.unescapeHTML()
+	return &createdBudgetLimit, nil
+}
+
+func (c *Client) GetBudgetLimit(ctx context.Context, budgetID, id string) (*BudgetLimit, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, budgetLimitsPath(budgetID)+"/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetLimitSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	budgetLimit := result.Data.Attributes
+	budgetLimit.ID = result.Data.ID
+	budgetLimit.unescapeHTML()
+	return &budgetLimit, nil
+}
+
+func (c *Client) UpdateBudgetLimit(ctx context.Context, budgetID, id string, budgetLimit *BudgetLimit) (*BudgetLimit, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, budgetLimitsPath(budgetID)+"/"+id, budgetLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var result BudgetLimitSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedBudgetLimit := result.Data.Attributes
+	updatedBudgetLimit.ID = result.Data.ID
+	updatedBudgetLimit.unescapeHTML()
+	return &updatedBudgetLimit, nil
+}
+
+func (c *Client) DeleteBudgetLimit(ctx context.Context, budgetID, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, budgetLimitsPath(budgetID)+"/"+id, nil)
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"html"
 	"net/http"
+	"net/url"
 )
 
 type Category struct {
@@ -16,6 +17,16 @@ type Category struct {
 	UpdatedAt string `json:"updated_at,omitempty"`
 	Name      string `json:"name"`
 	Notes     string `json:"notes"`
+
+	// Spent and Earned are only returned for a period, per currency.
+	Spent  []CategoryTotal `json:"spent,omitempty"`
+	Earned []CategoryTotal `json:"earned,omitempty"`
+}
+
+// CategoryTotal is the amount spent or earned in a category in one currency.
+type CategoryTotal struct {
+	CurrencyCode string `json:"currency_code"`
+	Sum          string `json:"sum"`
 }
 
 type CategorySingle struct {
@@ -68,6 +79,45 @@ func (c *Client) GetCategory(ctx context.Context, id string) (*Category, error)
 	return &category, nil
 }
 
+// GetCategoryInPeriod returns the category including the amounts spent and
+// earned between start and end, formatted as YYYY-MM-DD.
+func (c *Client) GetCategoryInPeriod(ctx context.Context, id, start, end string) (*Category, error) {
+	query := url.Values{"start": {start}, "end": {end}}
+
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/categories/"+id+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CategorySingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	category := result.Data.Attributes
+	category.ID = result.Data.ID
+	category.unescapeHTML()
+	return &category, nil
+}
+
+// ListCategories returns all categories, following pagination.
+func (c *Client) ListCategories(ctx context.Context) ([]Category, error) {
+	dataList, err := listAll[CategoryData](ctx, c, "/api/v1/categories")
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]Category, 0, len(dataList))
+	for _, data := range dataList {
+		category := data.Attributes
+		category.ID = data.ID
+		category.unescapeHTML()
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
 func (c *Client) UpdateCategory(ctx context.Context, id string, category *Category) (*Category, error) {
 	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/categories/"+id, category)
 	if err != nil {
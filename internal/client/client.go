@@ -10,12 +10,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	// UserGroupID scopes requests to a user group (financial administration).
+	// When empty, Firefly III uses the user's current user group.
+	UserGroupID string
+
+	server *serverInfo
 }
 
 type NotFoundError struct {
@@ -26,14 +35,49 @@ func (e *NotFoundError) Error() string {
 	return e.Message
 }
 
+// ForbiddenError is returned when the API key lacks the permissions for a
+// request, such as the owner role for instance-wide settings.
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// APIError is returned for other unsuccessful responses. Message holds the
+// message of the Firefly III error response, if any.
+type APIError struct {
+	StatusCode int
+	Body       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
 func NewClient(baseURL, apiKey string) *Client {
 	return &Client{
 		BaseURL:    baseURL,
 		APIKey:     apiKey,
 		HTTPClient: &http.Client{},
+		server:     &serverInfo{},
 	}
 }
 
+// WithUserGroup returns a copy of the client that scopes requests to the given
+// user group. The client itself is returned when id is empty or unchanged.
+func (c *Client) WithUserGroup(id string) *Client {
+	if id == "" || id == c.UserGroupID {
+		return c
+	}
+
+	scoped := *c
+	scoped.UserGroupID = id
+	return &scoped
+}
+
 func (c *Client) doRequest(ctx context.Context, method, path string, body any) ([]byte, error) {
 	var reqBody io.Reader
 	if body != nil {
@@ -44,13 +88,27 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	return c.doRawRequest(ctx, method, path, "application/json", reqBody)
+}
+
+// doRawRequest sends body as is with the given content type. It is used
+// directly for requests that do not have a JSON body, such as file uploads.
+func (c *Client) doRawRequest(ctx context.Context, method, path, contentType string, body io.Reader) ([]byte, error) {
+	if c.UserGroupID != "" {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		path += separator + "user_group_id=" + url.QueryEscape(c.UserGroupID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.APIKey)
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.HTTPClient.Do(req)
@@ -68,8 +126,19 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 		return nil, &NotFoundError{Message: "Resource not found"}
 	}
 
+	if resp.StatusCode == 403 {
+		return nil, &ForbiddenError{Message: fmt.Sprintf("API request forbidden: %s", string(respBody))}
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		apiErr := &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
+		var errorResponse struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(respBody, &errorResponse) == nil {
+			apiErr.Message = errorResponse.Message
+		}
+		return nil, apiErr
 	}
 
 	return respBody, nil
@@ -80,3 +149,53 @@ func IsNotFound(err error) bool {
 	var notFoundErr *NotFoundError
 	return errors.As(err, &notFoundErr)
 }
+
+func IsForbidden(err error) bool {
+	var forbiddenErr *ForbiddenError
+	return errors.As(err, &forbiddenErr)
+}
+
+// Meta holds the metadata Firefly III returns with lists.
+type Meta struct {
+	Pagination Pagination `json:"pagination"`
+}
+
+type Pagination struct {
+	Total       int `json:"total"`
+	Count       int `json:"count"`
+	PerPage     int `json:"per_page"`
+	CurrentPage int `json:"current_page"`
+	TotalPages  int `json:"total_pages"`
+}
+
+// listAll returns the data of all pages of the list endpoint at path, which
+// may already contain a query string. T is the data type of one list item.
+func listAll[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	var items []T
+
+	for page := 1; ; page++ {
+		respBody, err := c.doRequest(ctx, http.MethodGet, path+separator+"page="+strconv.Itoa(page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Data []T  `json:"data"`
+			Meta Meta `json:"meta"`
+		}
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		items = append(items, result.Data...)
+
+		if page >= result.Meta.Pagination.TotalPages {
+			return items, nil
+		}
+	}
+}
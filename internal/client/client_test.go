@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type listItem struct {
+	ID string `json:"id"`
+}
+
+func TestListAll(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Errorf("invalid page in %s", r.URL.RequestURI())
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprintf(w, `{"data":[{"id":"%d"},{"id":"%d"}],"meta":{"pagination":{"total":6,"count":2,"per_page":2,"current_page":%d,"total_pages":3}}}`,
+			2*page-1, 2*page, page)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "secret").WithUserGroup("4")
+
+	items, err := listAll[listItem](context.Background(), c, "/api/v1/rules?type=all")
+	if err != nil {
+		t.Fatalf("listAll() returned error: %s", err)
+	}
+
+	wantItems := []listItem{{"1"}, {"2"}, {"3"}, {"4"}, {"5"}, {"6"}}
+	if !reflect.DeepEqual(items, wantItems) {
+		t.Errorf("listAll() = %v, want %v", items, wantItems)
+	}
+
+	wantRequests := []string{
+		"/api/v1/rules?type=all&page=1&user_group_id=4",
+		"/api/v1/rules?type=all&page=2&user_group_id=4",
+		"/api/v1/rules?type=all&page=3&user_group_id=4",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %v, want %v", requests, wantRequests)
+	}
+}
+
+func TestListAllEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[],"meta":{"pagination":{"total":0,"count":0,"per_page":50,"current_page":1,"total_pages":0}}}`)
+	}))
+	defer server.Close()
+
+	items, err := listAll[listItem](context.Background(), NewClient(server.URL, "secret"), "/api/v1/tags")
+	if err != nil {
+		t.Fatalf("listAll() returned error: %s", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("listAll() = %v, want no items", items)
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":"1"}],"meta":{"pagination":{"total_pages":2}}}`)
+	}))
+	defer server.Close()
+
+	_, err := listAll[listItem](context.Background(), NewClient(server.URL, "secret"), "/api/v1/tags")
+	if !IsNotFound(err) {
+		t.Errorf("listAll() error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Configuration is an instance-wide setting. Value holds the JSON encoded
+// value, which is a boolean or an integer depending on the setting.
+type Configuration struct {
+	Title    string          `json:"title"`
+	Value    json.RawMessage `json:"value"`
+	Editable bool            `json:"editable"`
+}
+
+type ConfigurationSingle struct {
+	Data Configuration `json:"data"`
+}
+
+type configurationUpdate struct {
+	Value json.RawMessage `json:"value"`
+}
+
+// configurationPath returns the path of a setting. Firefly III prefixes the
+// names of all settings with "configuration.".
+func configurationPath(name string) string {
+	return "/api/v1/configuration/configuration." + name
+}
+
+func (c *Client) GetConfiguration(ctx context.Context, name string) (*Configuration, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, configurationPath(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConfigurationSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.Data, nil
+}
+
+// UpdateConfiguration changes a setting. This requires the owner role.
+func (c *Client) UpdateConfiguration(ctx context.Context, name string, value json.RawMessage) (*Configuration, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, configurationPath(name), &configurationUpdate{Value: value})
+	if err != nil {
+		return nil, err
+	}
+
+	var result ConfigurationSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result.Data, nil
+}
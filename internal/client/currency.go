@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type Currency struct {
+	ID            string `json:"id,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	DecimalPlaces int32  `json:"decimal_places"`
+	Enabled       bool   `json:"enabled"`
+	// Primary is reported as "default" by API versions before 6.3.
+	Primary bool `json:"primary,omitempty"`
+	Default bool `json:"default,omitempty"`
+}
+
+type CurrencySingle struct {
+	Data CurrencyData `json:"data"`
+}
+
+type CurrencyData struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Attributes Currency `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (cur *Currency) unescapeHTML() {
+	cur.Name = html.UnescapeString(cur.Name)
+	cur.Symbol = html.UnescapeString(cur.Symbol)
+}
+
+// currencyPath returns the API path of a currency. Currencies are addressed by
+// their code.
+func currencyPath(code string) string {
+	return "/api/v1/currencies/" + url.PathEscape(code)
+}
+
+// decodeCurrency unmarshals a single currency response
+func decodeCurrency(respBody []byte) (*Currency, error) {
+	var result CurrencySingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	currency := result.Data.Attributes
+	currency.ID = result.Data.ID
+	currency.Primary = currency.Primary || currency.Default
+	currency.unescapeHTML()
+	return &currency, nil
+}
+
+func (c *Client) CreateCurrency(ctx context.Context, currency *Currency) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/currencies", currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
+
+// GetCurrency retrieves a currency by code
+func (c *Client) GetCurrency(ctx context.Context, code string) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, currencyPath(code), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
+
+func (c *Client) UpdateCurrency(ctx context.Context, code string, currency *Currency) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, currencyPath(code), currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
+
+// currencyInUseCode is the error code Firefly III reports when a currency
+// cannot be deleted because it is in use.
+const currencyInUseCode = "200006"
+
+// IsCurrencyInUse reports whether err is Firefly III refusing to delete a
+// currency that is in use, which it reports as a server error with a message
+// that starts with currencyInUseCode.
+func IsCurrencyInUse(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && strings.HasPrefix(apiErr.Message, currencyInUseCode+":")
+}
+
+func (c *Client) DeleteCurrency(ctx context.Context, code string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, currencyPath(code), nil)
+	return err
+}
+
+func (c *Client) EnableCurrency(ctx context.Context, code string) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, currencyPath(code)+"/enable", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
+
+func (c *Client) DisableCurrency(ctx context.Context, code string) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, currencyPath(code)+"/disable", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
+
+// MakePrimaryCurrency makes the currency the primary currency of the user.
+// API versions before 6.3 call this the default currency.
+func (c *Client) MakePrimaryCurrency(ctx context.Context, code string) (*Currency, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, currencyPath(code)+"/primary", nil)
+	if IsNotFound(err) {
+		respBody, err = c.doRequest(ctx, http.MethodPost, currencyPath(code)+"/default", nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCurrency(respBody)
+}
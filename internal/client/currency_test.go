@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsCurrencyInUse(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		want   bool
+	}{
+		"in use":         {status: http.StatusInternalServerError, body: `{"message":"200006: Currency in use.","exception":"FireflyException"}`, want: true},
+		"other error":    {status: http.StatusInternalServerError, body: `{"message":"200005: Currency is the primary currency.","exception":"FireflyException"}`},
+		"code elsewhere": {status: http.StatusInternalServerError, body: `{"message":"Unexpected error","exception":"200006: Currency in use."}`},
+		"not json":       {status: http.StatusBadGateway, body: `200006: Currency in use.`},
+		"not found":      {status: http.StatusNotFound, body: `{"message":"Resource not found"}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			err := NewClient(server.URL, "secret").DeleteCurrency(context.Background(), "XTS")
+			if err == nil {
+				t.Fatal("DeleteCurrency() returned no error")
+			}
+			if got := IsCurrencyInUse(err); got != test.want {
+				t.Errorf("IsCurrencyInUse(%q) = %t, want %t", err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ExchangeRate is the rate to convert one currency into another on a date.
+// The exchange rate endpoints require Firefly III 6.2 or later.
+type ExchangeRate struct {
+	ID               string `json:"id,omitempty"`
+	CreatedAt        string `json:"created_at,omitempty"`
+	UpdatedAt        string `json:"updated_at,omitempty"`
+	FromCurrencyCode string `json:"from_currency_code,omitempty"`
+	ToCurrencyCode   string `json:"to_currency_code,omitempty"`
+	Rate             string `json:"rate"`
+	Date             string `json:"date"`
+}
+
+// exchangeRateCreate is the request body for new exchange rates, which takes
+// the currency codes under different names than the response.
+type exchangeRateCreate struct {
+	Date string `json:"date"`
+	Rate string `json:"rate"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type ExchangeRateSingle struct {
+	Data ExchangeRateData `json:"data"`
+}
+
+type ExchangeRateData struct {
+	Type       string       `json:"type"`
+	ID         string       `json:"id"`
+	Attributes ExchangeRate `json:"attributes"`
+}
+
+func (c *Client) CreateExchangeRate(ctx context.Context, exchangeRate *ExchangeRate) (*ExchangeRate, error) {
+	body := &exchangeRateCreate{
+		Date: exchangeRate.Date,
+		Rate: exchangeRate.Rate,
+		From: exchangeRate.FromCurrencyCode,
+		To:   exchangeRate.ToCurrencyCode,
+	}
+
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/exchange-rates", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRateSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdExchangeRate := result.Data.Attributes
+	createdExchangeRate.ID = result.Data.ID
+	return &createdExchangeRate, nil
+}
+
+func (c *Client) GetExchangeRate(ctx context.Context, id string) (*ExchangeRate, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/exchange-rates/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRateSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	exchangeRate := result.Data.Attributes
+	exchangeRate.ID = result.Data.ID
+	return &exchangeRate, nil
+}
+
+// GetExchangeRateByDate returns the rate from one currency to another on a
+// date, formatted as YYYY-MM-DD.
+func (c *Client) GetExchangeRateByDate(ctx context.Context, from, to, date string) (*ExchangeRate, error) {
+	path := fmt.Sprintf("/api/v1/exchange-rates/%s/%s/%s", url.PathEscape(from), url.PathEscape(to), url.PathEscape(date))
+
+	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRateSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	exchangeRate := result.Data.Attributes
+	exchangeRate.ID = result.Data.ID
+	return &exchangeRate, nil
+}
+
+func (c *Client) UpdateExchangeRate(ctx context.Context, id string, exchangeRate *ExchangeRate) (*ExchangeRate, error) {
+	body := &ExchangeRate{
+		Date: exchangeRate.Date,
+		Rate: exchangeRate.Rate,
+	}
+
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/exchange-rates/"+id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExchangeRateSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedExchangeRate := result.Data.Attributes
+	updatedExchangeRate.ID = result.Data.ID
+	return &updatedExchangeRate, nil
+}
+
+func (c *Client) DeleteExchangeRate(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/exchange-rates/"+id, nil)
+	return err
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// LinkType describes how two transactions are linked. Editable is false for
+// the link types Firefly III ships with, which cannot be changed or deleted.
+type LinkType struct {
+	ID        string `json:"id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Name      string `json:"name"`
+	Inward    string `json:"inward"`
+	Outward   string `json:"outward"`
+	Editable  bool   `json:"editable,omitempty"`
+}
+
+type LinkTypeSingle struct {
+	Data LinkTypeData `json:"data"`
+}
+
+type LinkTypeData struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Attributes LinkType `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (lt *LinkType) unescapeHTML() {
+	lt.Name = html.UnescapeString(lt.Name)
+	lt.Inward = html.UnescapeString(lt.Inward)
+	lt.Outward = html.UnescapeString(lt.Outward)
+}
+
+func (c *Client) CreateLinkType(ctx context.Context, linkType *LinkType) (*LinkType, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/link-types", linkType)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LinkTypeSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdLinkType := result.Data.Attributes
+	createdLinkType.ID = result.Data.ID
+	createdLinkType.unescapeHTML()
+	return &createdLinkType, nil
+}
+
+func (c *Client) GetLinkType(ctx context.Context, id string) (*LinkType, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/link-types/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LinkTypeSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	linkType := result.Data.Attributes
+	linkType.ID = result.Data.ID
+	linkType.unescapeHTML()
+	return &linkType, nil
+}
+
+func (c *Client) UpdateLinkType(ctx context.Context, id string, linkType *LinkType) (*LinkType, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/link-types/"+id, linkType)
+	if err != nil {
+		return nil, err
+	}
+
+	var result LinkTypeSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedLinkType := result.Data.Attributes
+	updatedLinkType.ID = result.Data.ID
+	updatedLinkType.unescapeHTML()
+	return &updatedLinkType, nil
+}
+
+func (c *Client) DeleteLinkType(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/link-types/"+id, nil)
+	return err
+}
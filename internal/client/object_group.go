@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type ObjectGroup struct {
+	ID        string `json:"id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Title     string `json:"title"`
+	Order     int32  `json:"order,omitempty"`
+}
+
+type ObjectGroupSingle struct {
+	Data ObjectGroupData `json:"data"`
+}
+
+type ObjectGroupData struct {
+	Type       string      `json:"type"`
+	ID         string      `json:"id"`
+	Attributes ObjectGroup `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (og *ObjectGroup) unescapeHTML() {
+	og.Title = html.UnescapeString(og.Title)
+}
+
+// ListObjectGroups returns all object groups, following pagination.
+func (c *Client) ListObjectGroups(ctx context.Context) ([]ObjectGroup, error) {
+	dataList, err := listAll[ObjectGroupData](ctx, c, "/api/v1/object-groups")
+	if err != nil {
+		return nil, err
+	}
+
+	objectGroups := make([]ObjectGroup, 0, len(dataList))
+	for _, data := range dataList {
+		objectGroup := data.Attributes
+		objectGroup.ID = data.ID
+		objectGroup.unescapeHTML()
+		objectGroups = append(objectGroups, objectGroup)
+	}
+
+	return objectGroups, nil
+}
+
+func (c *Client) GetObjectGroup(ctx context.Context, id string) (*ObjectGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/object-groups/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ObjectGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	objectGroup := result.Data.Attributes
+	objectGroup.ID = result.Data.ID
+	objectGroup.unescapeHTML()
+	return &objectGroup, nil
+}
+
+func (c *Client) UpdateObjectGroup(ctx context.Context, id string, objectGroup *ObjectGroup) (*ObjectGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/object-groups/"+id, objectGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ObjectGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedObjectGroup := result.Data.Attributes
+	updatedObjectGroup.ID = result.Data.ID
+	updatedObjectGroup.unescapeHTML()
+	return &updatedObjectGroup, nil
+}
+
+func (c *Client) DeleteObjectGroup(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/object-groups/"+id, nil)
+	return err
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type PiggyBank struct {
+	ID               string             `json:"id,omitempty"`
+	CreatedAt        string             `json:"created_at,omitempty"`
+	UpdatedAt        string             `json:"updated_at,omitempty"`
+	Name             string             `json:"name"`
+	Accounts         []PiggyBankAccount `json:"accounts"`
+	TargetAmount     *string            `json:"target_amount"`
+	CurrentAmount    string             `json:"current_amount,omitempty"`
+	StartDate        string             `json:"start_date,omitempty"`
+	TargetDate       *string            `json:"target_date"`
+	Order            int32              `json:"order,omitempty"`
+	Notes            string             `json:"notes"`
+	ObjectGroupID    string             `json:"object_group_id,omitempty"`
+	ObjectGroupTitle string             `json:"object_group_title"`
+}
+
+// PiggyBankAccount links a piggy bank to an asset account. CurrentAmount is
+// the amount saved in the piggy bank from that account.
+type PiggyBankAccount struct {
+	AccountID     string `json:"account_id"`
+	Name          string `json:"name,omitempty"`
+	CurrentAmount string `json:"current_amount,omitempty"`
+}
+
+type PiggyBankSingle struct {
+	Data PiggyBankData `json:"data"`
+}
+
+type PiggyBankData struct {
+	Type       string    `json:"type"`
+	ID         string    `json:"id"`
+	Attributes PiggyBank `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (pb *PiggyBank) unescapeHTML() {
+	pb.Name = html.UnescapeString(pb.Name)
+	pb.Notes = html.UnescapeString(pb.Notes)
+	pb.ObjectGroupTitle = html.UnescapeString(pb.ObjectGroupTitle)
+
+	for i := range pb.Accounts {
+		pb.Accounts[i].Name = html.UnescapeString(pb.Accounts[i].Name)
+	}
+}
+
+func (c *Client) CreatePiggyBank(ctx context.Context, piggyBank *PiggyBank) (*PiggyBank, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/piggy-banks", piggyBank)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PiggyBankSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdPiggyBank := result.Data.Attributes
+	createdPiggyBank.ID = result.Data.ID
+	createdPiggyBank.unescapeHTML()
+	return &createdPiggyBank, nil
+}
+
+func (c *Client) GetPiggyBank(ctx context.Context, id string) (*PiggyBank, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/piggy-banks/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PiggyBankSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	piggyBank := result.Data.Attributes
+	piggyBank.ID = result.Data.ID
+	piggyBank.unescapeHTML()
+	return &piggyBank, nil
+}
+
+func (c *Client) UpdatePiggyBank(ctx context.Context, id string, piggyBank *PiggyBank) (*PiggyBank, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/piggy-banks/"+id, piggyBank)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PiggyBankSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedPiggyBank := result.Data.Attributes
+	updatedPiggyBank.ID = result.Data.ID
+	updatedPiggyBank.unescapeHTML()
+	return &updatedPiggyBank, nil
+}
+
+func (c *Client) DeletePiggyBank(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/piggy-banks/"+id, nil)
+	return err
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Preference is a user preference. Data holds the JSON encoded value, which
+// can be of any JSON type depending on the preference.
+type Preference struct {
+	ID        string          `json:"id,omitempty"`
+	CreatedAt string          `json:"created_at,omitempty"`
+	UpdatedAt string          `json:"updated_at,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type PreferenceSingle struct {
+	Data PreferenceData `json:"data"`
+}
+
+type PreferenceData struct {
+	Type       string     `json:"type"`
+	ID         string     `json:"id"`
+	Attributes Preference `json:"attributes"`
+}
+
+func preferencePath(name string) string {
+	return "/api/v1/preferences/" + url.PathEscape(name)
+}
+
+func (c *Client) CreatePreference(ctx context.Context, preference *Preference) (*Preference, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/preferences", preference)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PreferenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdPreference := result.Data.Attributes
+	createdPreference.ID = result.Data.ID
+	return &createdPreference, nil
+}
+
+func (c *Client) GetPreference(ctx context.Context, name string) (*Preference, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, preferencePath(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PreferenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	preference := result.Data.Attributes
+	preference.ID = result.Data.ID
+	return &preference, nil
+}
+
+func (c *Client) UpdatePreference(ctx context.Context, name string, preference *Preference) (*Preference, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, preferencePath(name), preference)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PreferenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedPreference := result.Data.Attributes
+	updatedPreference.ID = result.Data.ID
+	return &updatedPreference, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type Recurrence struct {
+	ID              string                  `json:"id,omitempty"`
+	CreatedAt       string                  `json:"created_at,omitempty"`
+	UpdatedAt       string                  `json:"updated_at,omitempty"`
+	Type            string                  `json:"type"`
+	Title           string                  `json:"title"`
+	Description     string                  `json:"description"`
+	FirstDate       string                  `json:"first_date"`
+	RepeatUntil     *string                 `json:"repeat_until"`
+	NrOfRepetitions *int32                  `json:"nr_of_repetitions"`
+	ApplyRules      bool                    `json:"apply_rules"`
+	Active          bool                    `json:"active"`
+	Notes           string                  `json:"notes"`
+	Repetitions     []RecurrenceRepetition  `json:"repetitions"`
+	Transactions    []RecurrenceTransaction `json:"transactions"`
+}
+
+type RecurrenceRepetition struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type"`
+	Moment      string `json:"moment"`
+	Skip        int32  `json:"skip"`
+	Weekend     int32  `json:"weekend"`
+	Description string `json:"description,omitempty"`
+}
+
+type RecurrenceTransaction struct {
+	ID                  string   `json:"id,omitempty"`
+	Description         string   `json:"description"`
+	Amount              string   `json:"amount"`
+	ForeignAmount       string   `json:"foreign_amount,omitempty"`
+	CurrencyCode        string   `json:"currency_code,omitempty"`
+	ForeignCurrencyCode string   `json:"foreign_currency_code,omitempty"`
+	SourceID            string   `json:"source_id"`
+	DestinationID       string   `json:"destination_id"`
+	CategoryID          string   `json:"category_id,omitempty"`
+	BudgetID            string   `json:"budget_id,omitempty"`
+	PiggyBankID         string   `json:"piggy_bank_id,omitempty"`
+	Tags                []string `json:"tags"`
+}
+
+type RecurrenceSingle struct {
+	Data RecurrenceData `json:"data"`
+}
+
+type RecurrenceData struct {
+	Type       string     `json:"type"`
+	ID         string     `json:"id"`
+	Attributes Recurrence `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (r *Recurrence) unescapeHTML() {
+	r.Title = html.UnescapeString(r.Title)
+	r.Description = html.UnescapeString(r.Description)
+	r.Notes = html.UnescapeString(r.Notes)
+
+	for i := range r.Repetitions {
+		r.Repetitions[i].Description = html.UnescapeString(r.Repetitions[i].Description)
+	}
+
+	for i := range r.Transactions {
+		r.Transactions[i].Description = html.UnescapeString(r.Transactions[i].Description)
+		for j := range r.Transactions[i].Tags {
+			r.Transactions[i].Tags[j] = html.UnescapeString(r.Transactions[i].Tags[j])
+		}
+	}
+}
+
+func (c *Client) CreateRecurrence(ctx context.Context, recurrence *Recurrence) (*Recurrence, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/recurrences", recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurrenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdRecurrence := result.Data.Attributes
+	createdRecurrence.ID = result.Data.ID
+	createdRecurrence.unescapeHTML()
+	return &createdRecurrence, nil
+}
+
+func (c *Client) GetRecurrence(ctx context.Context, id string) (*Recurrence, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/recurrences/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurrenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	recurrence := result.Data.Attributes
+	recurrence.ID = result.Data.ID
+	recurrence.unescapeHTML()
+	return &recurrence, nil
+}
+
+func (c *Client) UpdateRecurrence(ctx context.Context, id string, recurrence *Recurrence) (*Recurrence, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/recurrences/"+id, recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	var result RecurrenceSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedRecurrence := result.Data.Attributes
+	updatedRecurrence.ID = result.Data.ID
+	updatedRecurrence.unescapeHTML()
+	return &updatedRecurrence, nil
+}
+
+func (c *Client) DeleteRecurrence(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/recurrences/"+id, nil)
+	return err
+}
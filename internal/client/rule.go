@@ -111,6 +111,24 @@ func (c *Client) GetRule(ctx context.Context, id string) (*Rule, error) {
 	return &rule, nil
 }
 
+// ListRules returns all rules, following pagination.
+func (c *Client) ListRules(ctx context.Context) ([]Rule, error) {
+	dataList, err := listAll[RuleData](ctx, c, "/api/v1/rules")
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]Rule, 0, len(dataList))
+	for _, data := range dataList {
+		rule := data.Attributes
+		rule.ID = data.ID
+		rule.unescapeHTML()
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 func (c *Client) UpdateRule(ctx context.Context, id string, rule *Rule) (*Rule, error) {
 	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/rules/"+id, rule)
 	if err != nil {
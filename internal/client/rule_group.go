@@ -71,6 +71,43 @@ func (c *Client) GetRuleGroup(ctx context.Context, id string) (*RuleGroup, error
 	return &ruleGroup, nil
 }
 
+// ListRuleGroups returns all rule groups, following pagination.
+func (c *Client) ListRuleGroups(ctx context.Context) ([]RuleGroup, error) {
+	dataList, err := listAll[RuleGroupData](ctx, c, "/api/v1/rule-groups")
+	if err != nil {
+		return nil, err
+	}
+
+	ruleGroups := make([]RuleGroup, 0, len(dataList))
+	for _, data := range dataList {
+		ruleGroup := data.Attributes
+		ruleGroup.ID = data.ID
+		ruleGroup.unescapeHTML()
+		ruleGroups = append(ruleGroups, ruleGroup)
+	}
+
+	return ruleGroups, nil
+}
+
+// ListRuleGroupRules returns all rules in the rule group, following
+// pagination.
+func (c *Client) ListRuleGroupRules(ctx context.Context, id string) ([]Rule, error) {
+	dataList, err := listAll[RuleData](ctx, c, "/api/v1/rule-groups/"+id+"/rules")
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]Rule, 0, len(dataList))
+	for _, data := range dataList {
+		rule := data.Attributes
+		rule.ID = data.ID
+		rule.unescapeHTML()
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 func (c *Client) UpdateRuleGroup(ctx context.Context, id string, ruleGroup *RuleGroup) (*RuleGroup, error) {
 	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/rule-groups/"+id, ruleGroup)
 	if err != nil {
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+)
+
+type Tag struct {
+	ID          string   `json:"id,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+	Tag         string   `json:"tag"`
+	Date        *string  `json:"date"`
+	Description string   `json:"description"`
+	Latitude    *float64 `json:"latitude"`
+	Longitude   *float64 `json:"longitude"`
+	ZoomLevel   *int32   `json:"zoom_level"`
+}
+
+type TagSingle struct {
+	Data TagData `json:"data"`
+}
+
+type TagData struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes Tag    `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (t *Tag) unescapeHTML() {
+	t.Tag = html.UnescapeString(t.Tag)
+	t.Description = html.UnescapeString(t.Description)
+}
+
+// tagPath returns the API path of a tag. Firefly III accepts either the ID or
+// the name of a tag, so the identifier is escaped to allow arbitrary names.
+func tagPath(idOrName string) string {
+	return "/api/v1/tags/" + url.PathEscape(idOrName)
+}
+
+func (c *Client) CreateTag(ctx context.Context, tag *Tag) (*Tag, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/tags", tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TagSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdTag := result.Data.Attributes
+	createdTag.ID = result.Data.ID
+	createdTag.unescapeHTML()
+	return &createdTag, nil
+}
+
+// GetTag retrieves a tag by ID or by name
+func (c *Client) GetTag(ctx context.Context, idOrName string) (*Tag, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, tagPath(idOrName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TagSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	tag := result.Data.Attributes
+	tag.ID = result.Data.ID
+	tag.unescapeHTML()
+	return &tag, nil
+}
+
+func (c *Client) UpdateTag(ctx context.Context, idOrName string, tag *Tag) (*Tag, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, tagPath(idOrName), tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TagSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedTag := result.Data.Attributes
+	updatedTag.ID = result.Data.ID
+	updatedTag.unescapeHTML()
+	return &updatedTag, nil
+}
+
+func (c *Client) DeleteTag(ctx context.Context, idOrName string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, tagPath(idOrName), nil)
+	return err
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// TransactionGroup is a transaction with one or more splits. Firefly III
+// stores every split as a transaction journal.
+type TransactionGroup struct {
+	ID           string             `json:"id,omitempty"`
+	CreatedAt    string             `json:"created_at,omitempty"`
+	UpdatedAt    string             `json:"updated_at,omitempty"`
+	GroupTitle   string             `json:"group_title"`
+	ApplyRules   bool               `json:"apply_rules"`
+	Transactions []TransactionSplit `json:"transactions"`
+}
+
+// TransactionSplit is a single split of a transaction group. Empty optional
+// fields are sent as empty strings, which Firefly III treats as null, so
+// that updates clear them.
+type TransactionSplit struct {
+	TransactionJournalID string   `json:"transaction_journal_id,omitempty"`
+	Type                 string   `json:"type"`
+	Date                 string   `json:"date"`
+	Amount               string   `json:"amount"`
+	Description          string   `json:"description"`
+	CurrencyCode         string   `json:"currency_code,omitempty"`
+	ForeignAmount        string   `json:"foreign_amount"`
+	ForeignCurrencyCode  string   `json:"foreign_currency_code"`
+	SourceID             string   `json:"source_id,omitempty"`
+	SourceName           string   `json:"source_name,omitempty"`
+	DestinationID        string   `json:"destination_id,omitempty"`
+	DestinationName      string   `json:"destination_name,omitempty"`
+	CategoryID           string   `json:"category_id"`
+	CategoryName         string   `json:"category_name"`
+	BudgetID             string   `json:"budget_id"`
+	Tags                 []string `json:"tags"`
+	Notes                string   `json:"notes"`
+}
+
+type TransactionGroupSingle struct {
+	Data TransactionGroupData `json:"data"`
+}
+
+type TransactionGroupData struct {
+	Type       string           `json:"type"`
+	ID         string           `json:"id"`
+	Attributes TransactionGroup `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (tg *TransactionGroup) unescapeHTML() {
+	tg.GroupTitle = html.UnescapeString(tg.GroupTitle)
+
+	for i := range tg.Transactions {
+		t := &tg.Transactions[i]
+		t.Description = html.UnescapeString(t.Description)
+		t.SourceName = html.UnescapeString(t.SourceName)
+		t.DestinationName = html.UnescapeString(t.DestinationName)
+		t.CategoryName = html.UnescapeString(t.CategoryName)
+		t.Notes = html.UnescapeString(t.Notes)
+		for j := range t.Tags {
+			t.Tags[j] = html.UnescapeString(t.Tags[j])
+		}
+	}
+}
+
+func (c *Client) CreateTransaction(ctx context.Context, transactionGroup *TransactionGroup) (*TransactionGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/transactions", transactionGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdTransactionGroup := result.Data.Attributes
+	createdTransactionGroup.ID = result.Data.ID
+	createdTransactionGroup.unescapeHTML()
+	return &createdTransactionGroup, nil
+}
+
+func (c *Client) GetTransaction(ctx context.Context, id string) (*TransactionGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/transactions/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	transactionGroup := result.Data.Attributes
+	transactionGroup.ID = result.Data.ID
+	transactionGroup.unescapeHTML()
+	return &transactionGroup, nil
+}
+
+// UpdateTransaction updates a transaction group. Splits with a transaction
+// journal ID are updated, splits without one are added, and existing splits
+// that are left out are deleted.
+func (c *Client) UpdateTransaction(ctx context.Context, id string, transactionGroup *TransactionGroup) (*TransactionGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/transactions/"+id, transactionGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedTransactionGroup := result.Data.Attributes
+	updatedTransactionGroup.ID = result.Data.ID
+	updatedTransactionGroup.unescapeHTML()
+	return &updatedTransactionGroup, nil
+}
+
+func (c *Client) DeleteTransaction(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/transactions/"+id, nil)
+	return err
+}
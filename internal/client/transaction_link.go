@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// TransactionLink links two transaction journals using a link type.
+type TransactionLink struct {
+	ID           string `json:"id,omitempty"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	UpdatedAt    string `json:"updated_at,omitempty"`
+	LinkTypeID   string `json:"link_type_id"`
+	LinkTypeName string `json:"link_type_name,omitempty"`
+	InwardID     string `json:"inward_id"`
+	OutwardID    string `json:"outward_id"`
+	Notes        string `json:"notes"`
+}
+
+type TransactionLinkSingle struct {
+	Data TransactionLinkData `json:"data"`
+}
+
+type TransactionLinkData struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id"`
+	Attributes TransactionLink `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (tl *TransactionLink) unescapeHTML() {
+	tl.LinkTypeName = html.UnescapeString(tl.LinkTypeName)
+	tl.Notes = html.UnescapeString(tl.Notes)
+}
+
+func (c *Client) CreateTransactionLink(ctx context.Context, transactionLink *TransactionLink) (*TransactionLink, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/transaction-links", transactionLink)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionLinkSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdTransactionLink := result.Data.Attributes
+	createdTransactionLink.ID = result.Data.ID
+	createdTransactionLink.unescapeHTML()
+	return &createdTransactionLink, nil
+}
+
+func (c *Client) GetTransactionLink(ctx context.Context, id string) (*TransactionLink, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/transaction-links/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionLinkSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	transactionLink := result.Data.Attributes
+	transactionLink.ID = result.Data.ID
+	transactionLink.unescapeHTML()
+	return &transactionLink, nil
+}
+
+func (c *Client) UpdateTransactionLink(ctx context.Context, id string, transactionLink *TransactionLink) (*TransactionLink, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/transaction-links/"+id, transactionLink)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TransactionLinkSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedTransactionLink := result.Data.Attributes
+	updatedTransactionLink.ID = result.Data.ID
+	updatedTransactionLink.unescapeHTML()
+	return &updatedTransactionLink, nil
+}
+
+func (c *Client) DeleteTransactionLink(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/transaction-links/"+id, nil)
+	return err
+}
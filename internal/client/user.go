@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// User is a user of the Firefly III instance. Managing users requires the
+// owner role.
+type User struct {
+	ID          string `json:"id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	Email       string `json:"email"`
+	Blocked     bool   `json:"blocked"`
+	BlockedCode string `json:"blocked_code"`
+	Role        string `json:"role"`
+}
+
+type UserSingle struct {
+	Data UserData `json:"data"`
+}
+
+type UserData struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes User   `json:"attributes"`
+}
+
+func (c *Client) CreateUser(ctx context.Context, user *User) (*User, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/users", user)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdUser := result.Data.Attributes
+	createdUser.ID = result.Data.ID
+	return &createdUser, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/users/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	user := result.Data.Attributes
+	user.ID = result.Data.ID
+	return &user, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, id string, user *User) (*User, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/users/"+id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedUser := result.Data.Attributes
+	updatedUser.ID = result.Data.ID
+	return &updatedUser, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/users/"+id, nil)
+	return err
+}
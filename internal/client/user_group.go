@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// UserGroup is a financial administration. Every user has at least one, and
+// all accounts, transactions and other financial data belong to one.
+type UserGroup struct {
+	ID                  string `json:"id,omitempty"`
+	CreatedAt           string `json:"created_at,omitempty"`
+	UpdatedAt           string `json:"updated_at,omitempty"`
+	Title               string `json:"title"`
+	InUse               bool   `json:"in_use,omitempty"`
+	PrimaryCurrencyCode string `json:"primary_currency_code,omitempty"`
+}
+
+type UserGroupSingle struct {
+	Data UserGroupData `json:"data"`
+}
+
+type UserGroupData struct {
+	Type       string    `json:"type"`
+	ID         string    `json:"id"`
+	Attributes UserGroup `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (ug *UserGroup) unescapeHTML() {
+	ug.Title = html.UnescapeString(ug.Title)
+}
+
+// ListUserGroups returns all user groups the user is a member of, following
+// pagination.
+func (c *Client) ListUserGroups(ctx context.Context) ([]UserGroup, error) {
+	dataList, err := listAll[UserGroupData](ctx, c, "/api/v1/user-groups")
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups := make([]UserGroup, 0, len(dataList))
+	for _, data := range dataList {
+		userGroup := data.Attributes
+		userGroup.ID = data.ID
+		userGroup.unescapeHTML()
+		userGroups = append(userGroups, userGroup)
+	}
+
+	return userGroups, nil
+}
+
+func (c *Client) GetUserGroup(ctx context.Context, id string) (*UserGroup, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/user-groups/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	userGroup := result.Data.Attributes
+	userGroup.ID = result.Data.ID
+	userGroup.unescapeHTML()
+	return &userGroup, nil
+}
+
+func (c *Client) UpdateUserGroup(ctx context.Context, id string, userGroup *UserGroup) (*UserGroup, error) {
+	body := &UserGroup{
+		Title:               userGroup.Title,
+		PrimaryCurrencyCode: userGroup.PrimaryCurrencyCode,
+	}
+
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/user-groups/"+id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UserGroupSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedUserGroup := result.Data.Attributes
+	updatedUserGroup.ID = result.Data.ID
+	updatedUserGroup.unescapeHTML()
+	return &updatedUserGroup, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+type Webhook struct {
+	ID        string `json:"id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	Trigger   string `json:"trigger"`
+	Response  string `json:"response"`
+	Delivery  string `json:"delivery"`
+	Active    bool   `json:"active"`
+	Secret    string `json:"secret,omitempty"`
+}
+
+// webhookUpdate is the request body for updates. Setting Secret makes Firefly
+// III generate a new signing secret.
+type webhookUpdate struct {
+	*Webhook
+	Secret bool `json:"secret,omitempty"`
+}
+
+type WebhookSingle struct {
+	Data WebhookData `json:"data"`
+}
+
+type WebhookData struct {
+	Type       string  `json:"type"`
+	ID         string  `json:"id"`
+	Attributes Webhook `json:"attributes"`
+}
+
+// unescapeHTML decodes HTML entities in all string fields
+func (w *Webhook) unescapeHTML() {
+	w.Title = html.UnescapeString(w.Title)
+	w.URL = html.UnescapeString(w.URL)
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, webhook *Webhook) (*Webhook, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPost, "/api/v1/webhooks", webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	createdWebhook := result.Data.Attributes
+	createdWebhook.ID = result.Data.ID
+	createdWebhook.unescapeHTML()
+	return &createdWebhook, nil
+}
+
+func (c *Client) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/api/v1/webhooks/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	webhook := result.Data.Attributes
+	webhook.ID = result.Data.ID
+	webhook.unescapeHTML()
+	return &webhook, nil
+}
+
+// UpdateWebhook updates a webhook. When regenerateSecret is true, Firefly III
+// replaces the signing secret of the webhook.
+func (c *Client) UpdateWebhook(ctx context.Context, id string, webhook *Webhook, regenerateSecret bool) (*Webhook, error) {
+	respBody, err := c.doRequest(ctx, http.MethodPut, "/api/v1/webhooks/"+id, webhookUpdate{Webhook: webhook, Secret: regenerateSecret})
+	if err != nil {
+		return nil, err
+	}
+
+	var result WebhookSingle
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	updatedWebhook := result.Data.Attributes
+	updatedWebhook.ID = result.Data.ID
+	updatedWebhook.unescapeHTML()
+	return &updatedWebhook, nil
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/webhooks/"+id, nil)
+	return err
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &AboutDataSource{}
+
+func NewAboutDataSource() datasource.DataSource {
+	return &AboutDataSource{}
+}
+
+type AboutDataSource struct {
+	client *client.Client
+}
+
+type AboutDataSourceModel struct {
+	Version    types.String `tfsdk:"version"`
+	APIVersion types.String `tfsdk:"api_version"`
+	PHPVersion types.String `tfsdk:"php_version"`
+	OS         types.String `tfsdk:"os"`
+	Driver     types.String `tfsdk:"driver"`
+	UserID     types.String `tfsdk:"user_id"`
+	UserEmail  types.String `tfsdk:"user_email"`
+	UserRole   types.String `tfsdk:"user_role"`
+}
+
+func (d *AboutDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_about"
+}
+
+func (d *AboutDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Describes the Firefly III server and the user the API key belongs to.",
+
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of Firefly III.",
+			},
+			"api_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the Firefly III API.",
+			},
+			"php_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of PHP the server runs on.",
+			},
+			"os": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The operating system of the server.",
+			},
+			"driver": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The database driver of the server (e.g., `mysql`, `pgsql` or `sqlite`).",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user the API key belongs to.",
+			},
+			"user_email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address of the user the API key belongs to.",
+			},
+			"user_role": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The role of the user the API key belongs to: `owner`, `demo`, or null for a regular user.",
+			},
+		},
+	}
+}
+
+func (d *AboutDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AboutDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AboutDataSourceModel
+
+	about, err := d.client.GetAbout(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server information, got error: %s", err))
+		return
+	}
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current user, got error: %s", err))
+		return
+	}
+
+	data.Version = types.StringValue(about.Version)
+	data.APIVersion = types.StringValue(about.APIVersion)
+	data.PHPVersion = types.StringValue(about.PHPVersion)
+	data.OS = types.StringValue(about.OS)
+	data.Driver = types.StringValue(about.Driver)
+	data.UserID = types.StringValue(user.ID)
+	data.UserEmail = types.StringValue(user.Email)
+	data.UserRole = nullableStringValue(user.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAboutDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "firefly3_about" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.firefly3_about.test", "version"),
+					resource.TestCheckResourceAttrSet("data.firefly3_about.test", "api_version"),
+					resource.TestCheckResourceAttrSet("data.firefly3_about.test", "user_email"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &AccountResource{}
+var _ resource.ResourceWithImportState = &AccountResource{}
+var _ resource.ResourceWithValidateConfig = &AccountResource{}
+
+func NewAccountResource() resource.Resource {
+	return &AccountResource{}
+}
+
+type AccountResource struct {
+	client *client.Client
+}
+
+type AccountResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	UserGroupID        types.String `tfsdk:"user_group_id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	AccountRole        types.String `tfsdk:"account_role"`
+	Active             types.Bool   `tfsdk:"active"`
+	CurrencyCode       types.String `tfsdk:"currency_code"`
+	IBAN               types.String `tfsdk:"iban"`
+	BIC                types.String `tfsdk:"bic"`
+	AccountNumber      types.String `tfsdk:"account_number"`
+	OpeningBalance     types.String `tfsdk:"opening_balance"`
+	OpeningBalanceDate types.String `tfsdk:"opening_balance_date"`
+	VirtualBalance     types.String `tfsdk:"virtual_balance"`
+	IncludeNetWorth    types.Bool   `tfsdk:"include_net_worth"`
+	CreditCardType     types.String `tfsdk:"credit_card_type"`
+	MonthlyPaymentDate types.String `tfsdk:"monthly_payment_date"`
+	LiabilityType      types.String `tfsdk:"liability_type"`
+	LiabilityDirection types.String `tfsdk:"liability_direction"`
+	Interest           types.String `tfsdk:"interest"`
+	InterestPeriod     types.String `tfsdk:"interest_period"`
+	Notes              types.String `tfsdk:"notes"`
+	CurrentBalance     types.String `tfsdk:"current_balance"`
+}
+
+func (r *AccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III account. Accounts can be asset, expense, revenue or liability accounts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Account/StoreRequest.php
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the account. Must be at most 1024 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the account. Must be one of: `asset`, `expense`, `revenue` or `liability`. Changing this forces a new account.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("asset", "expense", "revenue", "liability"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The role of an asset account. Required for asset accounts. Must be one of: `defaultAsset`, `sharedAsset`, `savingAsset`, `ccAsset` or `cashWalletAsset`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("defaultAsset", "sharedAsset", "savingAsset", "ccAsset", "cashWalletAsset"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the account is active. Defaults to `true`.",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The currency code of the account (e.g., `EUR`). Defaults to the primary currency of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iban": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The IBAN of the account.",
+			},
+			"bic": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The BIC of the account's bank.",
+			},
+			"account_number": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The account number, for accounts without an IBAN.",
+			},
+			"opening_balance": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The opening balance of the account as a decimal string. Requires `opening_balance_date`.",
+			},
+			"opening_balance_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date of the opening balance, formatted as `YYYY-MM-DD`.",
+			},
+			"virtual_balance": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The virtual balance of the account as a decimal string.",
+			},
+			"include_net_worth": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the account is included in net worth calculations. Defaults to `true`.",
+			},
+			"credit_card_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The credit card type of a `ccAsset` account. Must be `monthlyFull`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("monthlyFull"),
+				},
+			},
+			"monthly_payment_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The monthly payment date of a `ccAsset` account, formatted as `YYYY-MM-DD`.",
+			},
+			"liability_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The type of a liability account. Must be one of: `loan`, `debt` or `mortgage`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("loan", "debt", "mortgage"),
+				},
+			},
+			"liability_direction": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether the liability is owed to you (`debit`) or by you (`credit`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("credit", "debit"),
+				},
+			},
+			"interest": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The interest percentage of a liability account as a decimal string.",
+			},
+			"interest_period": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The period over which interest is calculated. Must be one of: `weekly`, `monthly`, `quarterly`, `half-year` or `yearly`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("weekly", "monthly", "quarterly", "half-year", "yearly"),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the account.",
+			},
+			"current_balance": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The current balance of the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccountResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	switch data.Type.ValueString() {
+	case "asset":
+		if data.AccountRole.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("account_role"), "Missing Account Role", "Asset accounts require an account_role.")
+		}
+	case "liability":
+		if data.LiabilityType.IsNull() || data.LiabilityDirection.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("liability_type"), "Missing Liability Details", "Liability accounts require both liability_type and liability_direction.")
+		}
+	}
+
+	if !data.CreditCardType.IsNull() && !data.AccountRole.IsUnknown() && data.AccountRole.ValueString() != "ccAsset" {
+		resp.Diagnostics.AddAttributeError(path.Root("credit_card_type"), "Invalid Credit Card Type", "credit_card_type can only be set on asset accounts with account_role `ccAsset`.")
+	}
+
+	if !data.OpeningBalance.IsNull() && data.OpeningBalanceDate.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("opening_balance_date"), "Missing Opening Balance Date", "opening_balance_date is required when opening_balance is set.")
+	}
+}
+
+func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	account := r.modelToAPIAccount(&data, &AccountResourceModel{})
+
+	createdAccount, err := apiClient.CreateAccount(ctx, account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create account, got error: %s", err))
+		return
+	}
+
+	r.apiAccountToModel(createdAccount, &data)
+
+	tflog.Trace(ctx, "created an account resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	account, err := apiClient.GetAccount(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Account not found", fmt.Sprintf("Account %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	r.apiAccountToModel(account, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	account := r.modelToAPIAccount(&data, &state)
+
+	updatedAccount, err := apiClient.UpdateAccount(ctx, data.ID.ValueString(), account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update account, got error: %s", err))
+		return
+	}
+
+	r.apiAccountToModel(updatedAccount, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteAccount(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete account, got error: %s", err))
+		return
+	}
+}
+
+func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// modelToAPIAccount converts data into an API account. Optional attributes
+// that are set in prior but not in data are cleared; prior is empty on create.
+func (r *AccountResource) modelToAPIAccount(data, prior *AccountResourceModel) *client.Account {
+	account := &client.Account{
+		Name:               data.Name.ValueString(),
+		Type:               data.Type.ValueString(),
+		AccountRole:        data.AccountRole.ValueString(),
+		Active:             data.Active.ValueBool(),
+		IBAN:               data.IBAN.ValueString(),
+		BIC:                data.BIC.ValueString(),
+		AccountNumber:      data.AccountNumber.ValueString(),
+		OpeningBalance:     updateStringPointer(data.OpeningBalance, prior.OpeningBalance),
+		OpeningBalanceDate: updateStringPointer(data.OpeningBalanceDate, prior.OpeningBalanceDate),
+		VirtualBalance:     updateStringPointer(data.VirtualBalance, prior.VirtualBalance),
+		IncludeNetWorth:    data.IncludeNetWorth.ValueBool(),
+		CreditCardType:     updateStringPointer(data.CreditCardType, prior.CreditCardType),
+		MonthlyPaymentDate: updateStringPointer(data.MonthlyPaymentDate, prior.MonthlyPaymentDate),
+		LiabilityType:      updateStringPointer(data.LiabilityType, prior.LiabilityType),
+		LiabilityDirection: updateStringPointer(data.LiabilityDirection, prior.LiabilityDirection),
+		Interest:           updateStringPointer(data.Interest, prior.Interest),
+		InterestPeriod:     updateStringPointer(data.InterestPeriod, prior.InterestPeriod),
+		Notes:              data.Notes.ValueString(),
+	}
+
+	// Firefly III requires the interest to be a number, and reports an
+	// interest of zero as unset.
+	if account.Interest != nil && *account.Interest == "" {
+		zero := "0"
+		account.Interest = &zero
+	}
+
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		account.CurrencyCode = data.CurrencyCode.ValueString()
+	}
+
+	return account
+}
+
+func (r *AccountResource) apiAccountToModel(account *client.Account, data *AccountResourceModel) {
+	data.ID = types.StringValue(account.ID)
+	data.Name = types.StringValue(account.Name)
+
+	// The API reports liability accounts with the plural "liabilities" type.
+	accountType := account.Type
+	if accountType == "liabilities" {
+		accountType = "liability"
+	}
+	data.Type = types.StringValue(accountType)
+
+	data.AccountRole = optionalStringValue(account.AccountRole, data.AccountRole)
+	data.Active = types.BoolValue(account.Active)
+	data.CurrencyCode = types.StringValue(account.CurrencyCode)
+	data.IBAN = optionalStringValue(account.IBAN, data.IBAN)
+	data.BIC = optionalStringValue(account.BIC, data.BIC)
+	data.AccountNumber = optionalStringValue(account.AccountNumber, data.AccountNumber)
+	data.OpeningBalance = decimalValue(stringValueOf(account.OpeningBalance), data.OpeningBalance)
+	data.OpeningBalanceDate = dateValue(stringValueOf(account.OpeningBalanceDate), data.OpeningBalanceDate)
+	data.VirtualBalance = decimalValue(stringValueOf(account.VirtualBalance), data.VirtualBalance)
+	data.IncludeNetWorth = types.BoolValue(account.IncludeNetWorth)
+	data.CreditCardType = optionalStringValue(stringValueOf(account.CreditCardType), data.CreditCardType)
+	data.MonthlyPaymentDate = dateValue(stringValueOf(account.MonthlyPaymentDate), data.MonthlyPaymentDate)
+	data.LiabilityType = optionalStringValue(stringValueOf(account.LiabilityType), data.LiabilityType)
+	data.LiabilityDirection = optionalStringValue(stringValueOf(account.LiabilityDirection), data.LiabilityDirection)
+	data.Interest = decimalValue(stringValueOf(account.Interest), data.Interest)
+	data.InterestPeriod = optionalStringValue(stringValueOf(account.InterestPeriod), data.InterestPeriod)
+	data.Notes = optionalStringValue(account.Notes, data.Notes)
+	data.CurrentBalance = types.StringValue(account.CurrentBalance)
+}
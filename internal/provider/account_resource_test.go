@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestModelToAPIAccount(t *testing.T) {
+	data := &AccountResourceModel{
+		Name:            types.StringValue("Savings"),
+		Type:            types.StringValue("asset"),
+		AccountRole:     types.StringValue("savingAsset"),
+		Active:          types.BoolValue(true),
+		VirtualBalance:  types.StringValue("100"),
+		IncludeNetWorth: types.BoolValue(true),
+	}
+	prior := &AccountResourceModel{
+		OpeningBalance:     types.StringValue("1250"),
+		OpeningBalanceDate: types.StringValue("2026-01-01"),
+		Interest:           types.StringValue("4.5"),
+	}
+
+	tests := map[string]struct {
+		prior *AccountResourceModel
+		want  map[string]any
+	}{
+		"create": {
+			prior: &AccountResourceModel{},
+			want:  map[string]any{"virtual_balance": "100"},
+		},
+		"update clears removed attributes": {
+			prior: prior,
+			want: map[string]any{
+				"virtual_balance":      "100",
+				"opening_balance":      "",
+				"opening_balance_date": "",
+				"interest":             "0",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			body, err := json.Marshal((&AccountResource{}).modelToAPIAccount(data, test.prior))
+			if err != nil {
+				t.Fatalf("unable to marshal account: %s", err)
+			}
+
+			var got map[string]any
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("unable to unmarshal account: %s", err)
+			}
+
+			for _, field := range []string{"opening_balance", "opening_balance_date", "virtual_balance", "credit_card_type",
+				"monthly_payment_date", "liability_type", "liability_direction", "interest", "interest_period"} {
+				want, wantOK := test.want[field]
+				if value, ok := got[field]; ok != wantOK || value != want {
+					t.Errorf("%s = %v (sent: %t), want %v (sent: %t)", field, value, ok, want, wantOK)
+				}
+			}
+		})
+	}
+}
+
+func TestAccAccountResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountResourceConfig(name, "1250"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_account.test", "id"),
+					resource.TestCheckResourceAttrSet("firefly3_account.test", "current_balance"),
+					resource.TestCheckResourceAttr("firefly3_account.test", "name", name),
+					resource.TestCheckResourceAttr("firefly3_account.test", "type", "asset"),
+					resource.TestCheckResourceAttr("firefly3_account.test", "opening_balance", "1250"),
+					resource.TestCheckResourceAttr("firefly3_account.test", "virtual_balance", "100"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountResourceConfig(name, "1500.50"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_account.test", "opening_balance", "1500.50"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "firefly3_account" "test" {
+  name         = %q
+  type         = "asset"
+  account_role = "defaultAsset"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("firefly3_account.test", "opening_balance"),
+					resource.TestCheckNoResourceAttr("firefly3_account.test", "opening_balance_date"),
+					resource.TestCheckNoResourceAttr("firefly3_account.test", "virtual_balance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountResourceConfig(name, openingBalance string) string {
+	return fmt.Sprintf(`
+resource "firefly3_account" "test" {
+  name                 = %[1]q
+  type                 = "asset"
+  account_role         = "defaultAsset"
+  opening_balance      = %[2]q
+  opening_balance_date = "2026-01-01"
+  virtual_balance      = "100"
+}
+`, name, openingBalance)
+}
+
+// testAccAssetAccountConfig returns an asset account and an expense account
+// named after name, for resources that move money between accounts.
+func testAccAssetAccountConfig(name string) string {
+	return fmt.Sprintf(`
+resource "firefly3_account" "asset" {
+  name         = "%[1]s asset"
+  type         = "asset"
+  account_role = "defaultAsset"
+}
+
+resource "firefly3_account" "expense" {
+  name = "%[1]s expense"
+  type = "expense"
+}
+`, name)
+}
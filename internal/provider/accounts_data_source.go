@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &AccountsDataSource{}
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &AccountsDataSource{}
+}
+
+type AccountsDataSource struct {
+	client *client.Client
+}
+
+type AccountsDataSourceModel struct {
+	UserGroupID  types.String `tfsdk:"user_group_id"`
+	Type         types.String `tfsdk:"type"`
+	Active       types.Bool   `tfsdk:"active"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Accounts     types.List   `tfsdk:"accounts"`
+}
+
+// accountAttrTypes are the attribute types of the objects in accounts.
+var accountAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"name":            types.StringType,
+	"type":            types.StringType,
+	"role":            types.StringType,
+	"iban":            types.StringType,
+	"currency_code":   types.StringType,
+	"current_balance": types.StringType,
+	"active":          types.BoolType,
+}
+
+func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Firefly III accounts, optionally filtered by type, status, name and currency.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list accounts of this type. Must be one of: `all`, `asset`, `cash`, `expense`, `revenue` or `liability`. Defaults to `all`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "asset", "cash", "expense", "revenue", "liability"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list active (`true`) or inactive (`false`) accounts. Lists both when not set.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list accounts whose name matches this [regular expression](https://pkg.go.dev/regexp/syntax).",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list accounts in this currency (e.g., `EUR`).",
+			},
+			"accounts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The accounts that match the filters, ordered as Firefly III returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the account.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the account.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the account.",
+						},
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role of an asset account, such as `ccAsset` for credit cards.",
+						},
+						"iban": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IBAN of the account.",
+						},
+						"currency_code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The code of the currency of the account.",
+						},
+						"current_balance": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The current balance of the account, as a decimal string.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether or not the account is active.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	accounts, err := apiClient.ListAccounts(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list accounts, got error: %s", err))
+		return
+	}
+
+	var accountValues []attr.Value
+	for _, account := range accounts {
+		if !data.Active.IsNull() && account.Active != data.Active.ValueBool() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(account.Name) {
+			continue
+		}
+		if !data.CurrencyCode.IsNull() && account.CurrencyCode != data.CurrencyCode.ValueString() {
+			continue
+		}
+
+		accountValue, _ := types.ObjectValue(accountAttrTypes, map[string]attr.Value{
+			"id":              types.StringValue(account.ID),
+			"name":            types.StringValue(account.Name),
+			"type":            types.StringValue(account.Type),
+			"role":            nullableStringValue(account.AccountRole),
+			"iban":            nullableStringValue(account.IBAN),
+			"currency_code":   nullableStringValue(account.CurrencyCode),
+			"current_balance": nullableStringValue(account.CurrentBalance),
+			"active":          types.BoolValue(account.Active),
+		})
+		accountValues = append(accountValues, accountValue)
+	}
+
+	data.Accounts, _ = types.ListValue(types.ObjectType{AttrTypes: accountAttrTypes}, accountValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
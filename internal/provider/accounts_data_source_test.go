@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountsDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetAccountConfig(name) + fmt.Sprintf(`
+data "firefly3_accounts" "test" {
+  type       = "asset"
+  name_regex = "^%s"
+
+  depends_on = [firefly3_account.asset]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly3_accounts.test", "accounts.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firefly3_accounts.test", "accounts.0.id", "firefly3_account.asset", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &AttachmentResource{}
+var _ resource.ResourceWithImportState = &AttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &AttachmentResource{}
+
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+type AttachmentResource struct {
+	client *client.Client
+}
+
+type AttachmentResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	UserGroupID    types.String `tfsdk:"user_group_id"`
+	Source         types.String `tfsdk:"source"`
+	ContentMD5     types.String `tfsdk:"content_md5"`
+	Filename       types.String `tfsdk:"filename"`
+	AttachableType types.String `tfsdk:"attachable_type"`
+	AttachableID   types.String `tfsdk:"attachable_id"`
+	Title          types.String `tfsdk:"title"`
+	Notes          types.String `tfsdk:"notes"`
+	Mime           types.String `tfsdk:"mime"`
+}
+
+func (r *AttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachment"
+}
+
+func (r *AttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III attachment. The content of a local file is uploaded and attached to an account, bill, budget, piggy bank, tag or transaction.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the attachment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"source": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the local file that is uploaded. The file is uploaded again whenever its content changes.",
+			},
+			"content_md5": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 hash of the content, used to detect changes to the local file.",
+			},
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Attachment/StoreRequest.php
+			"filename": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The file name of the attachment. Defaults to the base name of `source`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"attachable_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of object the file is attached to. Must be one of: `Account`, `Bill`, `Budget`, `PiggyBank`, `Tag` or `TransactionJournal`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Account", "Bill", "Budget", "PiggyBank", "Tag", "TransactionJournal"),
+				},
+			},
+			"attachable_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the object the file is attached to. For transactions, this is the `transaction_journal_id` of a split.",
+			},
+			"title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The title of the attachment. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the attachment.",
+			},
+			"mime": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MIME type of the content, as detected by Firefly III.",
+			},
+		},
+	}
+}
+
+func (r *AttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the local file, so changes to its content show up in the
+// plan, and defaults the file name to the base name of the source.
+func (r *AttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.Source.IsUnknown() {
+		return
+	}
+
+	contentMD5, err := fileMD5(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read File", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_md5"), types.StringValue(contentMD5))...)
+
+	if plan.Filename.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), types.StringValue(filepath.Base(plan.Source.ValueString())))...)
+	}
+
+	if plan.Mime.IsUnknown() && contentMD5 == state.ContentMD5.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mime"), state.Mime)...)
+	}
+}
+
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	attachment := r.modelToAPIAttachment(&data)
+
+	createdAttachment, err := apiClient.CreateAttachment(ctx, attachment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create attachment, got error: %s", err))
+		return
+	}
+
+	// Store the attachment right away, so it is not lost when the upload fails.
+	data.ID = types.StringValue(createdAttachment.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+
+	resp.Diagnostics.Append(r.upload(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploadedAttachment, err := apiClient.GetAttachment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attachment, got error: %s", err))
+		return
+	}
+
+	r.apiAttachmentToModel(uploadedAttachment, &data)
+
+	tflog.Trace(ctx, "created an attachment resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	attachment, err := apiClient.GetAttachment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Attachment not found", fmt.Sprintf("Attachment %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attachment, got error: %s", err))
+		return
+	}
+
+	r.apiAttachmentToModel(attachment, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	attachment := r.modelToAPIAttachment(&data)
+
+	_, err := apiClient.UpdateAttachment(ctx, data.ID.ValueString(), attachment)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update attachment, got error: %s", err))
+		return
+	}
+
+	if !data.ContentMD5.Equal(state.ContentMD5) {
+		resp.Diagnostics.Append(r.upload(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updatedAttachment, err := apiClient.GetAttachment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attachment, got error: %s", err))
+		return
+	}
+
+	r.apiAttachmentToModel(updatedAttachment, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteAttachment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete attachment, got error: %s", err))
+		return
+	}
+}
+
+func (r *AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload uploads the content of the source file.
+func (r *AttachmentResource) upload(ctx context.Context, data *AttachmentResourceModel) diag.Diagnostics {
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	var diags diag.Diagnostics
+
+	file, err := os.Open(data.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Unable to Read File", err.Error())
+		return diags
+	}
+	defer file.Close()
+
+	if err := apiClient.UploadAttachment(ctx, data.ID.ValueString(), file); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload attachment, got error: %s", err))
+	}
+
+	return diags
+}
+
+// fileMD5 returns the hex encoded MD5 hash of the content of a file, the same
+// hash Firefly III reports for attachments.
+func fileMD5(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (r *AttachmentResource) modelToAPIAttachment(data *AttachmentResourceModel) *client.Attachment {
+	return &client.Attachment{
+		Filename:       data.Filename.ValueString(),
+		AttachableType: data.AttachableType.ValueString(),
+		AttachableID:   data.AttachableID.ValueString(),
+		Title:          data.Title.ValueString(),
+		Notes:          data.Notes.ValueString(),
+	}
+}
+
+func (r *AttachmentResource) apiAttachmentToModel(attachment *client.Attachment, data *AttachmentResourceModel) {
+	data.ID = types.StringValue(attachment.ID)
+	data.ContentMD5 = types.StringValue(attachment.MD5)
+	data.Filename = types.StringValue(attachment.Filename)
+	data.AttachableType = types.StringValue(attachment.AttachableType)
+	data.AttachableID = types.StringValue(attachment.AttachableID)
+	data.Title = optionalStringValue(attachment.Title, data.Title)
+	data.Notes = optionalStringValue(attachment.Notes, data.Notes)
+	data.Mime = types.StringValue(attachment.Mime)
+}
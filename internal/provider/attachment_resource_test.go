@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAttachmentResourceModifyPlan(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "contract.txt")
+	writeTestFile(t, source, "first version")
+	sum := md5.Sum([]byte("first version"))
+	contentMD5 := hex.EncodeToString(sum[:])
+
+	attachment := func(filename, contentMD5, mime types.String) *AttachmentResourceModel {
+		return &AttachmentResourceModel{
+			ID:             types.StringValue("5"),
+			Source:         types.StringValue(source),
+			ContentMD5:     contentMD5,
+			Filename:       filename,
+			AttachableType: types.StringValue("Bill"),
+			AttachableID:   types.StringValue("3"),
+			Title:          types.StringValue("Contract"),
+			Mime:           mime,
+		}
+	}
+	unknown := types.StringUnknown()
+
+	tests := map[string]struct {
+		config       *AttachmentResourceModel
+		state        *AttachmentResourceModel
+		plan         *AttachmentResourceModel
+		wantFilename types.String
+		wantMime     types.String
+	}{
+		"create": {
+			config:       attachment(types.StringNull(), types.StringNull(), types.StringNull()),
+			plan:         attachment(unknown, unknown, unknown),
+			wantFilename: types.StringValue("contract.txt"),
+			wantMime:     unknown,
+		},
+		"configured filename": {
+			config:       attachment(types.StringValue("signed.txt"), types.StringNull(), types.StringNull()),
+			plan:         attachment(types.StringValue("signed.txt"), unknown, unknown),
+			wantFilename: types.StringValue("signed.txt"),
+			wantMime:     unknown,
+		},
+		"unchanged content": {
+			config:       attachment(types.StringNull(), types.StringNull(), types.StringNull()),
+			state:        attachment(types.StringValue("contract.txt"), types.StringValue(contentMD5), types.StringValue("text/plain")),
+			plan:         attachment(unknown, unknown, unknown),
+			wantFilename: types.StringValue("contract.txt"),
+			wantMime:     types.StringValue("text/plain"),
+		},
+		"changed content": {
+			config:       attachment(types.StringNull(), types.StringNull(), types.StringNull()),
+			state:        attachment(types.StringValue("contract.txt"), types.StringValue("d41d8cd98f00b204e9800998ecf8427e"), types.StringValue("text/plain")),
+			plan:         attachment(unknown, unknown, unknown),
+			wantFilename: types.StringValue("contract.txt"),
+			wantMime:     unknown,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := testModifyPlan(t, &AttachmentResource{}, test.config, test.state, test.plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !got.ContentMD5.Equal(types.StringValue(contentMD5)) {
+				t.Errorf("content_md5 = %s, want %s", got.ContentMD5, contentMD5)
+			}
+			if !got.Filename.Equal(test.wantFilename) {
+				t.Errorf("filename = %s, want %s", got.Filename, test.wantFilename)
+			}
+			if !got.Mime.Equal(test.wantMime) {
+				t.Errorf("mime = %s, want %s", got.Mime, test.wantMime)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		plan := attachment(unknown, unknown, unknown)
+		plan.Source = types.StringValue(filepath.Join(t.TempDir(), "missing.txt"))
+
+		_, diags := testModifyPlan(t, &AttachmentResource{}, plan, nil, plan)
+		if !diags.HasError() {
+			t.Error("expected an error for a missing file")
+		}
+	})
+}
+
+func TestAccAttachmentResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+	source := filepath.Join(t.TempDir(), "contract.txt")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeTestFile(t, source, "first version") },
+				Config:    testAccAttachmentResourceConfig(name, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_attachment.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_attachment.test", "filename", "contract.txt"),
+					resource.TestCheckResourceAttrPair("firefly3_attachment.test", "attachable_id", "firefly3_bill.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The local file is not known to Firefly III.
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+			{
+				PreConfig: func() { writeTestFile(t, source, "second version") },
+				Config:    testAccAttachmentResourceConfig(name, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_attachment.test", "content_md5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAttachmentResourceConfig(name, source string) string {
+	return testAccBillResourceConfig(name, "1200", false) + fmt.Sprintf(`
+resource "firefly3_attachment" "test" {
+  source          = %q
+  attachable_type = "Bill"
+  attachable_id   = firefly3_bill.test.id
+  title           = "Contract"
+}
+`, source)
+}
+
+func writeTestFile(t *testing.T, name, content string) {
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write %s: %s", name, err)
+	}
+}
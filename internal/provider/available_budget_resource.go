@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &AvailableBudgetResource{}
+var _ resource.ResourceWithImportState = &AvailableBudgetResource{}
+
+func NewAvailableBudgetResource() resource.Resource {
+	return &AvailableBudgetResource{}
+}
+
+type AvailableBudgetResource struct {
+	client *client.Client
+}
+
+type AvailableBudgetResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	UserGroupID  types.String `tfsdk:"user_group_id"`
+	Amount       types.String `tfsdk:"amount"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+}
+
+func (r *AvailableBudgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_budget"
+}
+
+func (r *AvailableBudgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III available budget. The available budget is the amount that can be divided over budgets in a period, as shown on the budget overview.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the available budget.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"amount": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The amount available in the period, as a decimal string.",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The currency code of the amount (e.g., `EUR`). Defaults to the primary currency of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The first day of the period, formatted as `YYYY-MM-DD`.",
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The last day of the period, formatted as `YYYY-MM-DD`.",
+			},
+		},
+	}
+}
+
+func (r *AvailableBudgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AvailableBudgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AvailableBudgetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	availableBudget := r.modelToAPIAvailableBudget(&data)
+
+	createdAvailableBudget, err := apiClient.CreateAvailableBudget(ctx, availableBudget)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create available budget, got error: %s", err))
+		return
+	}
+
+	r.apiAvailableBudgetToModel(createdAvailableBudget, &data)
+
+	tflog.Trace(ctx, "created an available budget resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AvailableBudgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AvailableBudgetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	availableBudget, err := apiClient.GetAvailableBudget(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Available budget not found", fmt.Sprintf("Available budget %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read available budget, got error: %s", err))
+		return
+	}
+
+	r.apiAvailableBudgetToModel(availableBudget, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AvailableBudgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AvailableBudgetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	availableBudget := r.modelToAPIAvailableBudget(&data)
+
+	updatedAvailableBudget, err := apiClient.UpdateAvailableBudget(ctx, data.ID.ValueString(), availableBudget)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update available budget, got error: %s", err))
+		return
+	}
+
+	r.apiAvailableBudgetToModel(updatedAvailableBudget, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AvailableBudgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AvailableBudgetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteAvailableBudget(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete available budget, got error: %s", err))
+		return
+	}
+}
+
+func (r *AvailableBudgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *AvailableBudgetResource) modelToAPIAvailableBudget(data *AvailableBudgetResourceModel) *client.AvailableBudget {
+	availableBudget := &client.AvailableBudget{
+		Amount: data.Amount.ValueString(),
+		Start:  data.Start.ValueString(),
+		End:    data.End.ValueString(),
+	}
+
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		availableBudget.CurrencyCode = data.CurrencyCode.ValueString()
+	}
+
+	return availableBudget
+}
+
+func (r *AvailableBudgetResource) apiAvailableBudgetToModel(availableBudget *client.AvailableBudget, data *AvailableBudgetResourceModel) {
+	data.ID = types.StringValue(availableBudget.ID)
+	data.Amount = decimalValue(availableBudget.Amount, data.Amount)
+	data.CurrencyCode = types.StringValue(availableBudget.CurrencyCode)
+	data.Start = dateValue(availableBudget.Start, data.Start)
+	data.End = dateValue(availableBudget.End, data.End)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAvailableBudgetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailableBudgetResourceConfig("36000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_available_budget.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_available_budget.test", "amount", "36000"),
+					resource.TestCheckResourceAttr("firefly3_available_budget.test", "start", "2030-01-01"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_available_budget.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAvailableBudgetResourceConfig("38000.00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_available_budget.test", "amount", "38000.00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAvailableBudgetResourceConfig(amount string) string {
+	return fmt.Sprintf(`
+resource "firefly3_available_budget" "test" {
+  amount = %q
+  start  = "2030-01-01"
+  end    = "2030-12-31"
+}
+`, amount)
+}
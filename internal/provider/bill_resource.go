@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &BillResource{}
+var _ resource.ResourceWithImportState = &BillResource{}
+var _ resource.ResourceWithModifyPlan = &BillResource{}
+
+func NewBillResource() resource.Resource {
+	return &BillResource{}
+}
+
+type BillResource struct {
+	client *client.Client
+}
+
+type BillResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserGroupID      types.String `tfsdk:"user_group_id"`
+	Name             types.String `tfsdk:"name"`
+	AmountMin        types.String `tfsdk:"amount_min"`
+	AmountMax        types.String `tfsdk:"amount_max"`
+	Date             types.String `tfsdk:"date"`
+	EndDate          types.String `tfsdk:"end_date"`
+	ExtensionDate    types.String `tfsdk:"extension_date"`
+	RepeatFreq       types.String `tfsdk:"repeat_freq"`
+	Skip             types.Int32  `tfsdk:"skip"`
+	CurrencyCode     types.String `tfsdk:"currency_code"`
+	Active           types.Bool   `tfsdk:"active"`
+	Notes            types.String `tfsdk:"notes"`
+	ObjectGroupID    types.String `tfsdk:"object_group_id"`
+	ObjectGroupTitle types.String `tfsdk:"object_group_title"`
+}
+
+func (r *BillResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bill"
+}
+
+func (r *BillResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III bill, also known as a subscription. Bills describe recurring expenses that transactions can be linked to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the bill.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Bill/StoreRequest.php
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bill. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"amount_min": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The minimum amount of a matching transaction, as a decimal string.",
+			},
+			"amount_max": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The maximum amount of a matching transaction, as a decimal string.",
+			},
+			"date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date of the first expected payment, formatted as `YYYY-MM-DD`.",
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date after which the bill is no longer expected, formatted as `YYYY-MM-DD`.",
+			},
+			"extension_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date on which the bill must be renewed or cancelled, formatted as `YYYY-MM-DD`.",
+			},
+			"repeat_freq": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "How often the bill is expected. Must be one of: `weekly`, `monthly`, `quarterly`, `half-year` or `yearly`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("weekly", "monthly", "quarterly", "half-year", "yearly"),
+				},
+			},
+			"skip": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				MarkdownDescription: "The number of periods to skip between payments. For example, `1` with `repeat_freq` `weekly` means every other week. Defaults to `0`.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The currency code of the amounts (e.g., `EUR`). Defaults to the primary currency of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the bill is active. Defaults to `true`.",
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the bill.",
+			},
+			"object_group_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the object group the bill is shown in, for example `firefly3_object_group.example.id`. Conflicts with `object_group_title`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("object_group_title")),
+				},
+			},
+			"object_group_title": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The title of the object group the bill is shown in. The group is created if it does not exist yet. Conflicts with `object_group_id`.",
+			},
+		},
+	}
+}
+
+func (r *BillResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the object group attributes, see modifyObjectGroupPlan.
+func (r *BillResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyObjectGroupPlan(ctx, req, resp)
+}
+
+func (r *BillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BillResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	bill := r.modelToAPIBill(&data)
+
+	var diags diag.Diagnostics
+	bill.ObjectGroupID, bill.ObjectGroupTitle, diags = objectGroupReference(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdBill, err := apiClient.CreateBill(ctx, bill)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create bill, got error: %s", err))
+		return
+	}
+
+	r.apiBillToModel(createdBill, &data)
+
+	tflog.Trace(ctx, "created a bill resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BillResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	bill, err := apiClient.GetBill(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Bill not found", fmt.Sprintf("Bill %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bill, got error: %s", err))
+		return
+	}
+
+	r.apiBillToModel(bill, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BillResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	bill := r.modelToAPIBill(&data)
+
+	var diags diag.Diagnostics
+	bill.ObjectGroupID, bill.ObjectGroupTitle, diags = objectGroupReference(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedBill, err := apiClient.UpdateBill(ctx, data.ID.ValueString(), bill)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bill, got error: %s", err))
+		return
+	}
+
+	r.apiBillToModel(updatedBill, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BillResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteBill(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete bill, got error: %s", err))
+		return
+	}
+}
+
+func (r *BillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *BillResource) modelToAPIBill(data *BillResourceModel) *client.Bill {
+	bill := &client.Bill{
+		Name:          data.Name.ValueString(),
+		AmountMin:     data.AmountMin.ValueString(),
+		AmountMax:     data.AmountMax.ValueString(),
+		Date:          data.Date.ValueString(),
+		EndDate:       data.EndDate.ValueStringPointer(),
+		ExtensionDate: data.ExtensionDate.ValueStringPointer(),
+		RepeatFreq:    data.RepeatFreq.ValueString(),
+		Skip:          data.Skip.ValueInt32(),
+		Active:        data.Active.ValueBool(),
+		Notes:         data.Notes.ValueString(),
+	}
+
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		bill.CurrencyCode = data.CurrencyCode.ValueString()
+	}
+
+	return bill
+}
+
+func (r *BillResource) apiBillToModel(bill *client.Bill, data *BillResourceModel) {
+	data.ID = types.StringValue(bill.ID)
+	data.Name = types.StringValue(bill.Name)
+	data.AmountMin = decimalValue(bill.AmountMin, data.AmountMin)
+	data.AmountMax = decimalValue(bill.AmountMax, data.AmountMax)
+	data.Date = dateValue(bill.Date, data.Date)
+	data.EndDate = dateValue(stringValueOf(bill.EndDate), data.EndDate)
+	data.ExtensionDate = dateValue(stringValueOf(bill.ExtensionDate), data.ExtensionDate)
+	data.RepeatFreq = types.StringValue(bill.RepeatFreq)
+	data.Skip = types.Int32Value(bill.Skip)
+	data.CurrencyCode = types.StringValue(bill.CurrencyCode)
+	data.Active = types.BoolValue(bill.Active)
+	data.Notes = optionalStringValue(bill.Notes, data.Notes)
+	data.ObjectGroupID = nullableStringValue(bill.ObjectGroupID)
+	data.ObjectGroupTitle = nullableStringValue(bill.ObjectGroupTitle)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBillResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillResourceConfig(name, "1200", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_bill.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_bill.test", "name", name),
+					resource.TestCheckResourceAttr("firefly3_bill.test", "amount_min", "1200"),
+					resource.TestCheckResourceAttr("firefly3_bill.test", "end_date", "2027-12-31"),
+					resource.TestCheckResourceAttr("firefly3_bill.test", "extension_date", "2027-10-01"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_bill.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillResourceConfig(name, "1250.00", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_bill.test", "amount_min", "1250.00"),
+					resource.TestCheckNoResourceAttr("firefly3_bill.test", "end_date"),
+					resource.TestCheckNoResourceAttr("firefly3_bill.test", "extension_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBillResourceConfig(name, amount string, withEndDate bool) string {
+	endDate := ""
+	if withEndDate {
+		endDate = `
+  end_date       = "2027-12-31"
+  extension_date = "2027-10-01"`
+	}
+
+	return fmt.Sprintf(`
+resource "firefly3_bill" "test" {
+  name        = %[1]q
+  amount_min  = %[2]q
+  amount_max  = %[2]q
+  date        = "2026-01-01"
+  repeat_freq = "monthly"%[3]s
+}
+`, name, amount, endDate)
+}
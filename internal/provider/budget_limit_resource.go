@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &BudgetLimitResource{}
+var _ resource.ResourceWithImportState = &BudgetLimitResource{}
+
+func NewBudgetLimitResource() resource.Resource {
+	return &BudgetLimitResource{}
+}
+
+type BudgetLimitResource struct {
+	client *client.Client
+}
+
+type BudgetLimitResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	UserGroupID  types.String `tfsdk:"user_group_id"`
+	BudgetID     types.String `tfsdk:"budget_id"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+	Amount       types.String `tfsdk:"amount"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	Notes        types.String `tfsdk:"notes"`
+}
+
+func (r *BudgetLimitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget_limit"
+}
+
+func (r *BudgetLimitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III budget limit. A budget limit sets the amount available in a budget for a specific period.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the budget limit.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"budget_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the budget the limit belongs to. Changing this forces a new budget limit.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The first day of the period, formatted as `YYYY-MM-DD`.",
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The last day of the period, formatted as `YYYY-MM-DD`.",
+			},
+			"amount": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The amount available in the period, as a decimal string.",
+			},
+			"currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The currency code of the amount (e.g., `EUR`). Defaults to the primary currency of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the budget limit.",
+			},
+		},
+	}
+}
+
+func (r *BudgetLimitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BudgetLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BudgetLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budgetLimit := r.modelToAPIBudgetLimit(&data)
+
+	createdBudgetLimit, err := apiClient.CreateBudgetLimit(ctx, data.BudgetID.ValueString(), budgetLimit)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create budget limit, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetLimitToModel(createdBudgetLimit, &data)
+
+	tflog.Trace(ctx, "created a budget limit resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BudgetLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budgetLimit, err := apiClient.GetBudgetLimit(ctx, data.BudgetID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Budget limit not found", fmt.Sprintf("Budget limit %s of budget %s not found", data.ID.ValueString(), data.BudgetID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read budget limit, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetLimitToModel(budgetLimit, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BudgetLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budgetLimit := r.modelToAPIBudgetLimit(&data)
+
+	updatedBudgetLimit, err := apiClient.UpdateBudgetLimit(ctx, data.BudgetID.ValueString(), data.ID.ValueString(), budgetLimit)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update budget limit, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetLimitToModel(updatedBudgetLimit, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BudgetLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteBudgetLimit(ctx, data.BudgetID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete budget limit, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts an ID in the form budget_id/limit_id, as budget limits
+// can only be addressed through their budget, optionally prefixed with the
+// user group as user_group_id:budget_id/limit_id.
+func (r *BudgetLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	budgetID, limitID, ok := strings.Cut(importUserGroupID(ctx, req, resp), "/")
+	if !ok || budgetID == "" || limitID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: budget_id/limit_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("budget_id"), budgetID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), limitID)...)
+}
+
+func (r *BudgetLimitResource) modelToAPIBudgetLimit(data *BudgetLimitResourceModel) *client.BudgetLimit {
+	budgetLimit := &client.BudgetLimit{
+		Start:  data.Start.ValueString(),
+		End:    data.End.ValueString(),
+		Amount: data.Amount.ValueString(),
+		Notes:  data.Notes.ValueString(),
+	}
+
+	if !data.CurrencyCode.IsNull() && !data.CurrencyCode.IsUnknown() {
+		budgetLimit.CurrencyCode = data.CurrencyCode.ValueString()
+	}
+
+	return budgetLimit
+}
+
+func (r *BudgetLimitResource) apiBudgetLimitToModel(budgetLimit *client.BudgetLimit, data *BudgetLimitResourceModel) {
+	data.ID = types.StringValue(budgetLimit.ID)
+	if budgetLimit.BudgetID != "" {
+		data.BudgetID = types.StringValue(budgetLimit.BudgetID)
+	}
+	data.Start = dateValue(budgetLimit.Start, data.Start)
+	data.End = dateValue(budgetLimit.End, data.End)
+	data.Amount = decimalValue(budgetLimit.Amount, data.Amount)
+	data.CurrencyCode = types.StringValue(budgetLimit.CurrencyCode)
+	data.Notes = optionalStringValue(budgetLimit.Notes, data.Notes)
+}
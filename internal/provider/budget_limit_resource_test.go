@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBudgetLimitResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetLimitResourceConfig(name, "600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_budget_limit.test", "id"),
+					resource.TestCheckResourceAttrPair("firefly3_budget_limit.test", "budget_id", "firefly3_budget.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_budget_limit.test", "amount", "600"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_budget_limit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["firefly3_budget_limit.test"]
+					return rs.Primary.Attributes["budget_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccBudgetLimitResourceConfig(name, "650.50"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_budget_limit.test", "amount", "650.50"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBudgetLimitResourceConfig(name, amount string) string {
+	return testAccBudgetResourceConfig(name) + fmt.Sprintf(`
+resource "firefly3_budget_limit" "test" {
+  budget_id = firefly3_budget.test.id
+  start     = "2026-01-01"
+  end       = "2026-01-31"
+  amount    = %q
+}
+`, amount)
+}
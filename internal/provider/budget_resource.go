@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &BudgetResource{}
+var _ resource.ResourceWithImportState = &BudgetResource{}
+var _ resource.ResourceWithValidateConfig = &BudgetResource{}
+
+func NewBudgetResource() resource.Resource {
+	return &BudgetResource{}
+}
+
+type BudgetResource struct {
+	client *client.Client
+}
+
+type BudgetResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	UserGroupID            types.String `tfsdk:"user_group_id"`
+	Name                   types.String `tfsdk:"name"`
+	Active                 types.Bool   `tfsdk:"active"`
+	Order                  types.Int32  `tfsdk:"order"`
+	Notes                  types.String `tfsdk:"notes"`
+	AutoBudgetType         types.String `tfsdk:"auto_budget_type"`
+	AutoBudgetCurrencyCode types.String `tfsdk:"auto_budget_currency_code"`
+	AutoBudgetAmount       types.String `tfsdk:"auto_budget_amount"`
+	AutoBudgetPeriod       types.String `tfsdk:"auto_budget_period"`
+}
+
+func (r *BudgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget"
+}
+
+func (r *BudgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III budget. Budgets can optionally be filled automatically every period using an auto-budget.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the budget.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Budget/StoreRequest.php
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the budget. Must be at most 100 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the budget is active. Defaults to `true`.",
+			},
+			"order": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The order of the budget in the budget overview.",
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the budget.",
+			},
+			"auto_budget_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				MarkdownDescription: "The type of auto-budget. Must be one of: `none`, `reset`, `rollover` or `adjusted`. Defaults to `none`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "reset", "rollover", "adjusted"),
+				},
+			},
+			"auto_budget_currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The currency code of the auto-budget amount (e.g., `EUR`). Defaults to the primary currency of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_budget_amount": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The amount that is budgeted every period, as a decimal string. Required unless `auto_budget_type` is `none`.",
+			},
+			"auto_budget_period": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The period of the auto-budget. Must be one of: `daily`, `weekly`, `monthly`, `quarterly`, `half-year` or `yearly`. Required unless `auto_budget_type` is `none`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("daily", "weekly", "monthly", "quarterly", "half-year", "yearly"),
+				},
+			},
+		},
+	}
+}
+
+func (r *BudgetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BudgetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AutoBudgetType.IsNull() || data.AutoBudgetType.IsUnknown() || data.AutoBudgetType.ValueString() == "none" {
+		return
+	}
+
+	if data.AutoBudgetAmount.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_budget_amount"), "Missing Auto-Budget Amount", "auto_budget_amount is required when auto_budget_type is set.")
+	}
+
+	if data.AutoBudgetPeriod.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_budget_period"), "Missing Auto-Budget Period", "auto_budget_period is required when auto_budget_type is set.")
+	}
+}
+
+func (r *BudgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BudgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BudgetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budget := r.modelToAPIBudget(&data)
+
+	createdBudget, err := apiClient.CreateBudget(ctx, budget)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create budget, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetToModel(createdBudget, &data)
+
+	tflog.Trace(ctx, "created a budget resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BudgetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budget, err := apiClient.GetBudget(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Budget not found", fmt.Sprintf("Budget %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read budget, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetToModel(budget, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BudgetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	budget := r.modelToAPIBudget(&data)
+
+	updatedBudget, err := apiClient.UpdateBudget(ctx, data.ID.ValueString(), budget)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update budget, got error: %s", err))
+		return
+	}
+
+	r.apiBudgetToModel(updatedBudget, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BudgetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteBudget(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete budget, got error: %s", err))
+		return
+	}
+}
+
+func (r *BudgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *BudgetResource) modelToAPIBudget(data *BudgetResourceModel) *client.Budget {
+	budget := &client.Budget{
+		Name:             data.Name.ValueString(),
+		Active:           data.Active.ValueBool(),
+		Notes:            data.Notes.ValueString(),
+		AutoBudgetType:   data.AutoBudgetType.ValueString(),
+		AutoBudgetAmount: data.AutoBudgetAmount.ValueString(),
+		AutoBudgetPeriod: data.AutoBudgetPeriod.ValueString(),
+	}
+
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
+		budget.Order = data.Order.ValueInt32()
+	}
+
+	if !data.AutoBudgetCurrencyCode.IsNull() && !data.AutoBudgetCurrencyCode.IsUnknown() {
+		budget.AutoBudgetCurrencyCode = data.AutoBudgetCurrencyCode.ValueString()
+	}
+
+	return budget
+}
+
+func (r *BudgetResource) apiBudgetToModel(budget *client.Budget, data *BudgetResourceModel) {
+	data.ID = types.StringValue(budget.ID)
+	data.Name = types.StringValue(budget.Name)
+	data.Active = types.BoolValue(budget.Active)
+	data.Order = types.Int32Value(budget.Order)
+	data.Notes = optionalStringValue(budget.Notes, data.Notes)
+	data.AutoBudgetType = types.StringValue(budget.AutoBudgetType)
+	data.AutoBudgetCurrencyCode = types.StringValue(budget.AutoBudgetCurrencyCode)
+	data.AutoBudgetAmount = decimalValue(budget.AutoBudgetAmount, data.AutoBudgetAmount)
+	data.AutoBudgetPeriod = optionalStringValue(budget.AutoBudgetPeriod, data.AutoBudgetPeriod)
+}
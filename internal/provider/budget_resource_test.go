@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBudgetResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_budget.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_budget.test", "name", name),
+				),
+			},
+			{
+				ResourceName:      "firefly3_budget.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBudgetResourceConfig(name + " renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_budget.test", "name", name+" renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBudgetResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "firefly3_budget" "test" {
+  name = %q
+}
+`, name)
+}
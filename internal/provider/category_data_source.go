@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &CategoryDataSource{}
+
+func NewCategoryDataSource() datasource.DataSource {
+	return &CategoryDataSource{}
+}
+
+type CategoryDataSource struct {
+	client *client.Client
+}
+
+type CategoryDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	Name        types.String `tfsdk:"name"`
+	Notes       types.String `tfsdk:"notes"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Start       types.String `tfsdk:"start"`
+	End         types.String `tfsdk:"end"`
+	Spent       types.List   `tfsdk:"spent"`
+	Earned      types.List   `tfsdk:"earned"`
+}
+
+// categoryTotalAttrTypes are the attribute types of the spent and earned
+// objects.
+var categoryTotalAttrTypes = map[string]attr.Type{
+	"currency_code": types.StringType,
+	"sum":           types.StringType,
+}
+
+func (d *CategoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+func (d *CategoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	totalAttributes := map[string]schema.Attribute{
+		"currency_code": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The code of the currency of the sum.",
+		},
+		"sum": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The sum, as a decimal string.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Firefly III category by ID or by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the category. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The exact name of the category.",
+			},
+			"notes": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A description of the category.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the category was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the category was last updated.",
+			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The first date of the period to return `spent` and `earned` for, formatted as `YYYY-MM-DD`. Requires `end`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("end")),
+				},
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The last date of the period to return `spent` and `earned` for, formatted as `YYYY-MM-DD`. Requires `start`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("start")),
+				},
+			},
+			"spent": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The amounts spent in the category between `start` and `end`, per currency. Empty without a period.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: totalAttributes,
+				},
+			},
+			"earned": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The amounts earned in the category between `start` and `end`, per currency. Empty without a period.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: totalAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *CategoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CategoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	id := data.ID.ValueString()
+	if data.ID.IsNull() {
+		categories, err := apiClient.ListCategories(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list categories, got error: %s", err))
+			return
+		}
+
+		for _, category := range categories {
+			if category.Name == data.Name.ValueString() {
+				id = category.ID
+				break
+			}
+		}
+
+		if id == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Category Not Found",
+				fmt.Sprintf("No category with name %q exists.", data.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	var category *client.Category
+	var err error
+	if data.Start.IsNull() {
+		category, err = apiClient.GetCategory(ctx, id)
+	} else {
+		category, err = apiClient.GetCategoryInPeriod(ctx, id, data.Start.ValueString(), data.End.ValueString())
+	}
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Category Not Found", fmt.Sprintf("No category with ID %s exists.", id))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(category.ID)
+	data.Name = types.StringValue(category.Name)
+	data.Notes = nullableStringValue(category.Notes)
+	data.CreatedAt = types.StringValue(category.CreatedAt)
+	data.UpdatedAt = types.StringValue(category.UpdatedAt)
+
+	data.Spent = categoryTotalsValue(category.Spent)
+	data.Earned = categoryTotalsValue(category.Earned)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// categoryTotalsValue converts API totals into a list of spent or earned
+// objects.
+func categoryTotalsValue(totals []client.CategoryTotal) types.List {
+	totalValues := make([]attr.Value, len(totals))
+	for i, total := range totals {
+		totalValues[i], _ = types.ObjectValue(categoryTotalAttrTypes, map[string]attr.Value{
+			"currency_code": types.StringValue(total.CurrencyCode),
+			"sum":           types.StringValue(total.Sum),
+		})
+	}
+
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: categoryTotalAttrTypes}, totalValues)
+	return list
+}
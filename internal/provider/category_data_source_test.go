@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCategoryDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "firefly3_category" "test" {
+  name = %q
+}
+
+data "firefly3_category" "test" {
+  name  = firefly3_category.test.name
+  start = "2026-01-01"
+  end   = "2026-01-31"
+
+  depends_on = [firefly3_category.test]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firefly3_category.test", "id", "firefly3_category.test", "id"),
+					resource.TestCheckResourceAttr("data.firefly3_category.test", "spent.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -32,9 +32,10 @@ type CategoryResource struct {
 }
 
 type CategoryResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Notes types.String `tfsdk:"notes"`
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	Name        types.String `tfsdk:"name"`
+	Notes       types.String `tfsdk:"notes"`
 }
 
 func (r *CategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +54,7 @@ func (r *CategoryResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"user_group_id": userGroupIDAttribute(),
 			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Category/UpdateRequest.php#L62
 			"name": schema.StringAttribute{
 				Required:            true,
@@ -94,13 +96,15 @@ func (r *CategoryResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	category, diags := r.modelToAPICategory(&data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createdCategory, err := r.client.CreateCategory(ctx, category)
+	createdCategory, err := apiClient.CreateCategory(ctx, category)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create category, got error: %s", err))
 		return
@@ -121,7 +125,9 @@ func (r *CategoryResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	category, err := r.client.GetCategory(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	category, err := apiClient.GetCategory(ctx, data.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.Diagnostics.AddWarning("Category not found", fmt.Sprintf("Category %s not found", data.ID.ValueString()))
@@ -145,13 +151,15 @@ func (r *CategoryResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	category, diags := r.modelToAPICategory(&data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updatedCategory, err := r.client.UpdateCategory(ctx, data.ID.ValueString(), category)
+	updatedCategory, err := apiClient.UpdateCategory(ctx, data.ID.ValueString(), category)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update category, got error: %s, category %+v", err, category))
 		return
@@ -170,7 +178,9 @@ func (r *CategoryResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteCategory(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteCategory(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete category, got error: %s", err))
 		return
@@ -178,6 +188,7 @@ func (r *CategoryResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *CategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &ConfigurationResource{}
+var _ resource.ResourceWithImportState = &ConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &ConfigurationResource{}
+
+// writableConfigurations maps the settings that can be changed through the
+// API to the type of their value.
+var writableConfigurations = map[string]string{
+	"is_demo_site":            "bool",
+	"permission_update_check": "int",
+	"single_user_mode":        "bool",
+}
+
+func NewConfigurationResource() resource.Resource {
+	return &ConfigurationResource{}
+}
+
+type ConfigurationResource struct {
+	client *client.Client
+}
+
+type ConfigurationResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *ConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration"
+}
+
+func (r *ConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	names := make([]string, 0, len(writableConfigurations))
+	for name := range writableConfigurations {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III configuration value. Configuration values are instance-wide settings that can only be changed by the owner of the instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the configuration value, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The name of the configuration value. Must be one of: `is_demo_site`, `permission_update_check` or `single_user_mode`. " +
+					"Changing this forces a new resource.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(names...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The value, as a string. Must be `true` or `false` for `is_demo_site` and `single_user_mode`, " +
+					"and `-1` (not asked yet), `0` (disabled) or `1` (enabled) for `permission_update_check`.",
+			},
+		},
+	}
+}
+
+func (r *ConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Name.IsUnknown() || data.Value.IsUnknown() {
+		return
+	}
+
+	// Unknown names are reported by the validator of name.
+	if _, ok := writableConfigurations[data.Name.ValueString()]; !ok {
+		return
+	}
+
+	if _, err := encodeConfigurationValue(data.Name.ValueString(), data.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Configuration Value",
+			err.Error(),
+		)
+	}
+}
+
+func (r *ConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a configuration resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetConfiguration(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Configuration not found", fmt.Sprintf("Configuration %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(ownerRequiredError("read", "configuration", err)...)
+		return
+	}
+
+	data.Name = types.StringValue(data.ID.ValueString())
+	data.Value = configurationValue(configuration.Value, data.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &data, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the configuration value from the Terraform state,
+// because configuration values cannot be deleted. The setting keeps its value.
+func (r *ConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "configuration values cannot be deleted, removing from state only", map[string]any{"name": data.Name.ValueString()})
+}
+
+func (r *ConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// update writes the configured value and stores the result in data.
+func (r *ConfigurationResource) update(ctx context.Context, data *ConfigurationResourceModel, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := encodeConfigurationValue(data.Name.ValueString(), data.Value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("value"), "Invalid Configuration Value", err.Error())
+		return diags
+	}
+
+	configuration, err := r.client.UpdateConfiguration(ctx, data.Name.ValueString(), value)
+	if err != nil {
+		return ownerRequiredError(action, "configuration", err)
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Value = configurationValue(configuration.Value, data.Value)
+
+	return diags
+}
+
+// encodeConfigurationValue converts the string value of a setting into the
+// JSON type Firefly III expects.
+func encodeConfigurationValue(name, value string) (json.RawMessage, error) {
+	switch writableConfigurations[name] {
+	case "bool":
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("%s must be true or false, got %q", name, value)
+		}
+		return json.RawMessage(value), nil
+	case "int":
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer, got %q", name, value)
+		}
+		if name == "permission_update_check" && (number < -1 || number > 1) {
+			return nil, fmt.Errorf("%s must be -1, 0 or 1, got %q", name, value)
+		}
+		return json.RawMessage(strconv.FormatInt(number, 10)), nil
+	}
+
+	return nil, fmt.Errorf("%s is not a writable configuration value", name)
+}
+
+// configurationValue converts an API value into a state value. Firefly III
+// normalizes integers ("01" becomes 1), so the configured value is kept as
+// long as it is numerically equal.
+func configurationValue(value json.RawMessage, prior types.String) types.String {
+	decoded := decodeConfigurationValue(value)
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		apiNumber, apiErr := strconv.ParseInt(decoded, 10, 64)
+		priorNumber, priorErr := strconv.ParseInt(prior.ValueString(), 10, 64)
+		if apiErr == nil && priorErr == nil && apiNumber == priorNumber {
+			return prior
+		}
+	}
+
+	return types.StringValue(decoded)
+}
+
+// decodeConfigurationValue converts a JSON value into its string form.
+func decodeConfigurationValue(value json.RawMessage) string {
+	var decoded string
+	if err := json.Unmarshal(value, &decoded); err == nil {
+		return decoded
+	}
+
+	return string(value)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEncodeConfigurationValue(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"bool":                      {name: "single_user_mode", value: "true", want: "true"},
+		"invalid bool":              {name: "single_user_mode", value: "yes", wantErr: true},
+		"int":                       {name: "permission_update_check", value: "-1", want: "-1"},
+		"int normalized":            {name: "permission_update_check", value: "01", want: "1"},
+		"int out of range":          {name: "permission_update_check", value: "2", wantErr: true},
+		"invalid int":               {name: "permission_update_check", value: "enabled", wantErr: true},
+		"last update check":         {name: "last_update_check", value: "1767225600", wantErr: true},
+		"unknown configuration key": {name: "unknown", value: "true", wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := encodeConfigurationValue(test.name, test.value)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("encodeConfigurationValue(%q, %q) = %s, want an error", test.name, test.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("encodeConfigurationValue(%q, %q) returned error: %s", test.name, test.value, err)
+			}
+			if string(got) != test.want {
+				t.Errorf("encodeConfigurationValue(%q, %q) = %s, want %s", test.name, test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigurationValue(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		prior types.String
+		want  types.String
+	}{
+		"bool": {
+			value: "false",
+			prior: types.StringValue("false"),
+			want:  types.StringValue("false"),
+		},
+		"numerically equal": {
+			value: "1",
+			prior: types.StringValue("01"),
+			want:  types.StringValue("01"),
+		},
+		"changed": {
+			value: "0",
+			prior: types.StringValue("1"),
+			want:  types.StringValue("0"),
+		},
+		"string encoded": {
+			value: `"1"`,
+			prior: types.StringNull(),
+			want:  types.StringValue("1"),
+		},
+		"import": {
+			value: "true",
+			prior: types.StringNull(),
+			want:  types.StringValue("true"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := configurationValue(json.RawMessage(test.value), test.prior); !got.Equal(test.want) {
+				t.Errorf("configurationValue(%s, %s) = %s, want %s", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAccConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationResourceConfig("0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_configuration.test", "id", "permission_update_check"),
+					resource.TestCheckResourceAttr("firefly3_configuration.test", "value", "0"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationResourceConfig("-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_configuration.test", "value", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "firefly3_configuration" "test" {
+  name  = "permission_update_check"
+  value = %q
+}
+`, value)
+}
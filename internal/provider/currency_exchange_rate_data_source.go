@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &CurrencyExchangeRateDataSource{}
+
+func NewCurrencyExchangeRateDataSource() datasource.DataSource {
+	return &CurrencyExchangeRateDataSource{}
+}
+
+type CurrencyExchangeRateDataSource struct {
+	client *client.Client
+}
+
+type CurrencyExchangeRateDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Date        types.String `tfsdk:"date"`
+	Rate        types.String `tfsdk:"rate"`
+}
+
+func (d *CurrencyExchangeRateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency_exchange_rate"
+}
+
+func (d *CurrencyExchangeRateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the Firefly III exchange rate between two currencies on a date. Requires Firefly III 6.2 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the exchange rate.",
+			},
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"from": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code of the currency that is converted (e.g., `EUR`).",
+			},
+			"to": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code of the currency that is converted into (e.g., `USD`).",
+			},
+			"date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date to look up the rate for, formatted as `YYYY-MM-DD`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "must be formatted as YYYY-MM-DD"),
+				},
+			},
+			"rate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The amount of `to` currency that one unit of `from` currency is worth, as a decimal string.",
+			},
+		},
+	}
+}
+
+func (d *CurrencyExchangeRateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrencyExchangeRateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrencyExchangeRateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	exchangeRate, err := apiClient.GetExchangeRateByDate(ctx, data.From.ValueString(), data.To.ValueString(), data.Date.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Exchange Rate Not Found",
+				fmt.Sprintf("No exchange rate from %s to %s found for %s.", data.From.ValueString(), data.To.ValueString(), data.Date.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read exchange rate, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(exchangeRate.ID)
+	data.Rate = types.StringValue(exchangeRate.Rate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrencyExchangeRateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCurrencyExchangeRateDataSourceConfig("2031-01-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firefly3_currency_exchange_rate.test", "id", "firefly3_currency_exchange_rate.test", "id"),
+					resource.TestCheckResourceAttr("data.firefly3_currency_exchange_rate.test", "rate", "0.965"),
+				),
+			},
+			{
+				Config:      testAccCurrencyExchangeRateDataSourceConfig("2031/01/01"),
+				ExpectError: regexp.MustCompile("must be formatted as YYYY-MM-DD"),
+			},
+		},
+	})
+}
+
+func testAccCurrencyExchangeRateDataSourceConfig(date string) string {
+	return fmt.Sprintf(`
+resource "firefly3_currency_exchange_rate" "test" {
+  from = "USD"
+  to   = "EUR"
+  date = "2031-01-01"
+  rate = "0.965"
+}
+
+data "firefly3_currency_exchange_rate" "test" {
+  from = firefly3_currency_exchange_rate.test.from
+  to   = firefly3_currency_exchange_rate.test.to
+  date = %q
+
+  depends_on = [firefly3_currency_exchange_rate.test]
+}
+`, date)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &CurrencyExchangeRateResource{}
+var _ resource.ResourceWithImportState = &CurrencyExchangeRateResource{}
+
+func NewCurrencyExchangeRateResource() resource.Resource {
+	return &CurrencyExchangeRateResource{}
+}
+
+type CurrencyExchangeRateResource struct {
+	client *client.Client
+}
+
+type CurrencyExchangeRateResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Date        types.String `tfsdk:"date"`
+	Rate        types.String `tfsdk:"rate"`
+}
+
+func (r *CurrencyExchangeRateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency_exchange_rate"
+}
+
+func (r *CurrencyExchangeRateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III currency exchange rate. Requires Firefly III 6.2 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the exchange rate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"from": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code of the currency that is converted (e.g., `EUR`). Changing this forces a new exchange rate.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 51),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code of the currency that is converted into (e.g., `USD`). Changing this forces a new exchange rate.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 51),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date the rate applies to, formatted as `YYYY-MM-DD`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegex, "must be formatted as YYYY-MM-DD"),
+				},
+			},
+			"rate": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The amount of `to` currency that one unit of `from` currency is worth, as a decimal string.",
+			},
+		},
+	}
+}
+
+func (r *CurrencyExchangeRateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CurrencyExchangeRateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CurrencyExchangeRateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	exchangeRate := r.modelToAPIExchangeRate(&data)
+
+	createdExchangeRate, err := apiClient.CreateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create exchange rate, got error: %s", err))
+		return
+	}
+
+	r.apiExchangeRateToModel(createdExchangeRate, &data)
+
+	tflog.Trace(ctx, "created a currency exchange rate resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyExchangeRateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CurrencyExchangeRateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	exchangeRate, err := apiClient.GetExchangeRate(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Exchange rate not found", fmt.Sprintf("Exchange rate %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read exchange rate, got error: %s", err))
+		return
+	}
+
+	r.apiExchangeRateToModel(exchangeRate, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyExchangeRateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CurrencyExchangeRateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	exchangeRate := r.modelToAPIExchangeRate(&data)
+
+	updatedExchangeRate, err := apiClient.UpdateExchangeRate(ctx, data.ID.ValueString(), exchangeRate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update exchange rate, got error: %s", err))
+		return
+	}
+
+	r.apiExchangeRateToModel(updatedExchangeRate, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyExchangeRateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CurrencyExchangeRateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteExchangeRate(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete exchange rate, got error: %s", err))
+		return
+	}
+}
+
+func (r *CurrencyExchangeRateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *CurrencyExchangeRateResource) modelToAPIExchangeRate(data *CurrencyExchangeRateResourceModel) *client.ExchangeRate {
+	return &client.ExchangeRate{
+		FromCurrencyCode: data.From.ValueString(),
+		ToCurrencyCode:   data.To.ValueString(),
+		Date:             data.Date.ValueString(),
+		Rate:             data.Rate.ValueString(),
+	}
+}
+
+func (r *CurrencyExchangeRateResource) apiExchangeRateToModel(exchangeRate *client.ExchangeRate, data *CurrencyExchangeRateResourceModel) {
+	data.ID = types.StringValue(exchangeRate.ID)
+	data.From = types.StringValue(exchangeRate.FromCurrencyCode)
+	data.To = types.StringValue(exchangeRate.ToCurrencyCode)
+	data.Date = dateValue(exchangeRate.Date, data.Date)
+	data.Rate = decimalValue(exchangeRate.Rate, data.Rate)
+}
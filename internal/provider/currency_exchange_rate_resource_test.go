@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrencyExchangeRateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "firefly3_currency_exchange_rate" "test" {
+  from = "EUR"
+  to   = "USD"
+  date = "01-01-2030"
+  rate = "1.035"
+}
+`,
+				ExpectError: regexp.MustCompile("must be formatted as YYYY-MM-DD"),
+			},
+			{
+				Config: testAccCurrencyExchangeRateResourceConfig("1.035"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_currency_exchange_rate.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_currency_exchange_rate.test", "rate", "1.035"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_currency_exchange_rate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCurrencyExchangeRateResourceConfig("1.04"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_currency_exchange_rate.test", "rate", "1.04"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCurrencyExchangeRateResourceConfig(rate string) string {
+	return fmt.Sprintf(`
+resource "firefly3_currency_exchange_rate" "test" {
+  from = "EUR"
+  to   = "USD"
+  date = "2030-01-01"
+  rate = %q
+}
+`, rate)
+}
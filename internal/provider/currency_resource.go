@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &CurrencyResource{}
+var _ resource.ResourceWithImportState = &CurrencyResource{}
+
+func NewCurrencyResource() resource.Resource {
+	return &CurrencyResource{}
+}
+
+type CurrencyResource struct {
+	client *client.Client
+}
+
+type CurrencyResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserGroupID   types.String `tfsdk:"user_group_id"`
+	Code          types.String `tfsdk:"code"`
+	Name          types.String `tfsdk:"name"`
+	Symbol        types.String `tfsdk:"symbol"`
+	DecimalPlaces types.Int32  `tfsdk:"decimal_places"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Primary       types.Bool   `tfsdk:"primary"`
+	Adopted       types.Bool   `tfsdk:"adopted"`
+}
+
+func (r *CurrencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency"
+}
+
+func (r *CurrencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III currency. Firefly III ships with most currencies already present, so an existing currency with the same code is adopted instead of created.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the currency, which is its code.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Lengths: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/TransactionCurrency/StoreRequest.php
+			"code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The code of the currency (e.g., `EUR`). Must be between 3 and 51 characters. Changing this forces a new currency.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 51),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the currency. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"symbol": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The symbol of the currency (e.g., `€`). Must be at most 51 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(51),
+				},
+			},
+			"decimal_places": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(2),
+				MarkdownDescription: "The number of decimal places of the currency. Defaults to `2`.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, 12),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the currency is enabled. Only enabled currencies can be used in Firefly III. Defaults to `true`.",
+			},
+			"primary": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Whether the currency is the primary (default) currency of the user. Set to `true` to make it the primary currency. " +
+					"A currency stops being primary only when another currency is made primary.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopted": schema.BoolAttribute{
+				Computed: true,
+				MarkdownDescription: "Whether the currency already existed in Firefly III when it was created or imported. " +
+					"An adopted currency is only removed from the Terraform state on destroy.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CurrencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CurrencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CurrencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	currency := r.modelToAPICurrency(&data)
+
+	existingCurrency, err := apiClient.GetCurrency(ctx, currency.Code)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency, got error: %s", err))
+		return
+	}
+
+	var createdCurrency *client.Currency
+	if existingCurrency != nil {
+		tflog.Debug(ctx, "adopting existing currency", map[string]any{"code": currency.Code})
+		createdCurrency, err = apiClient.UpdateCurrency(ctx, currency.Code, currency)
+	} else {
+		createdCurrency, err = apiClient.CreateCurrency(ctx, currency)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create currency, got error: %s", err))
+		return
+	}
+
+	createdCurrency, err = r.applyStatus(ctx, createdCurrency, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create currency, got error: %s", err))
+		return
+	}
+
+	r.apiCurrencyToModel(createdCurrency, &data)
+	data.Adopted = types.BoolValue(existingCurrency != nil)
+
+	tflog.Trace(ctx, "created a currency resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CurrencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	currency, err := apiClient.GetCurrency(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Currency not found", fmt.Sprintf("Currency %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency, got error: %s", err))
+		return
+	}
+
+	r.apiCurrencyToModel(currency, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CurrencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	if state.Primary.ValueBool() && !data.Primary.IsUnknown() && !data.Primary.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary"),
+			"Cannot Unset Primary Currency",
+			fmt.Sprintf("Currency %s is the primary currency. Make another currency primary instead of setting primary to false.", data.Code.ValueString()),
+		)
+		return
+	}
+
+	currency := r.modelToAPICurrency(&data)
+
+	updatedCurrency, err := apiClient.UpdateCurrency(ctx, data.ID.ValueString(), currency)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
+		return
+	}
+
+	updatedCurrency, err = r.applyStatus(ctx, updatedCurrency, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
+		return
+	}
+
+	r.apiCurrencyToModel(updatedCurrency, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the currency. Adopted currencies are only removed from the
+// state. Firefly III refuses to delete currencies that are in use, in which
+// case the currency is disabled instead.
+func (r *CurrencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CurrencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Adopted.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Currency not deleted",
+			fmt.Sprintf("Currency %s already existed before it was managed by Terraform and has only been removed from the state.", data.ID.ValueString()),
+		)
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteCurrency(ctx, data.ID.ValueString())
+	if err == nil || client.IsNotFound(err) {
+		return
+	}
+	if !client.IsCurrencyInUse(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete currency, got error: %s", err))
+		return
+	}
+
+	if _, disableErr := apiClient.DisableCurrency(ctx, data.ID.ValueString()); disableErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable currency, got error: %s", disableErr))
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Currency disabled instead of deleted",
+		fmt.Sprintf("Currency %s is in use and has been disabled instead of deleted.", data.ID.ValueString()),
+	)
+}
+
+func (r *CurrencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopted"), true)...)
+}
+
+// applyStatus enables, disables or makes the currency primary according to
+// the plan, using the dedicated endpoints.
+func (r *CurrencyResource) applyStatus(ctx context.Context, currency *client.Currency, data *CurrencyResourceModel) (*client.Currency, error) {
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	var err error
+
+	if data.Enabled.ValueBool() && !currency.Enabled {
+		currency, err = apiClient.EnableCurrency(ctx, currency.Code)
+	} else if !data.Enabled.ValueBool() && currency.Enabled {
+		currency, err = apiClient.DisableCurrency(ctx, currency.Code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Primary.ValueBool() && !currency.Primary {
+		currency, err = apiClient.MakePrimaryCurrency(ctx, currency.Code)
+	}
+
+	return currency, err
+}
+
+func (r *CurrencyResource) modelToAPICurrency(data *CurrencyResourceModel) *client.Currency {
+	return &client.Currency{
+		Code:          data.Code.ValueString(),
+		Name:          data.Name.ValueString(),
+		Symbol:        data.Symbol.ValueString(),
+		DecimalPlaces: data.DecimalPlaces.ValueInt32(),
+		Enabled:       data.Enabled.ValueBool(),
+	}
+}
+
+func (r *CurrencyResource) apiCurrencyToModel(currency *client.Currency, data *CurrencyResourceModel) {
+	data.ID = types.StringValue(currency.Code)
+	data.Code = types.StringValue(currency.Code)
+	data.Name = types.StringValue(currency.Name)
+	data.Symbol = types.StringValue(currency.Symbol)
+	data.DecimalPlaces = types.Int32Value(currency.DecimalPlaces)
+	data.Enabled = types.BoolValue(currency.Enabled)
+	data.Primary = types.BoolValue(currency.Primary)
+}
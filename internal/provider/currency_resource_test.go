@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrencyResource(t *testing.T) {
+	code := "TF" + strings.ToUpper(acctest.RandStringFromCharSet(4, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCurrencyResourceConfig(code, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_currency.test", "id", code),
+					resource.TestCheckResourceAttr("firefly3_currency.test", "decimal_places", "4"),
+					resource.TestCheckResourceAttr("firefly3_currency.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("firefly3_currency.test", "adopted", "false"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_currency.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported currencies are always adopted.
+				ImportStateVerifyIgnore: []string{"adopted"},
+			},
+			{
+				Config: testAccCurrencyResourceConfig(code, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_currency.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCurrencyResourceConfig(code string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "firefly3_currency" "test" {
+  code           = %[1]q
+  name           = "Test currency %[1]s"
+  symbol         = "T"
+  decimal_places = 4
+  enabled        = %[2]t
+}
+`, code, enabled)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// dateRegex matches a YYYY-MM-DD date.
+var dateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// optionalStringValue converts an API string into a state value for an
+// optional attribute. Firefly III returns unset fields as empty strings or
+// null, so an empty value stays null unless the practitioner configured "".
+func optionalStringValue(value string, prior types.String) types.String {
+	if value == "" && (prior.IsNull() || prior.IsUnknown()) {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// decimalValue converts an API amount into a state value. Firefly III formats
+// amounts using the currency's decimal places ("600.00"), so the configured
+// value is kept as long as it is numerically equal.
+func decimalValue(value string, prior types.String) types.String {
+	apiValue, err := strconv.ParseFloat(value, 64)
+	if value == "" || (err == nil && apiValue == 0) {
+		if prior.IsNull() || prior.IsUnknown() {
+			return types.StringNull()
+		}
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() && err == nil {
+		if priorValue, err := strconv.ParseFloat(prior.ValueString(), 64); err == nil && priorValue == apiValue {
+			return prior
+		}
+	}
+
+	return types.StringValue(value)
+}
+
+// dateValue converts an API date into a YYYY-MM-DD state value. Firefly III
+// returns most dates as full ISO 8601 timestamps.
+func dateValue(value string, prior types.String) types.String {
+	if value == "" {
+		return optionalStringValue(value, prior)
+	}
+
+	if len(value) > 10 {
+		value = value[:10]
+	}
+
+	return types.StringValue(value)
+}
+
+// dateTimeValue converts an API timestamp into a state value for an attribute
+// that accepts a YYYY-MM-DD date or an RFC 3339 timestamp. A configured date
+// stays a date and a configured timestamp is kept as long as it is the same
+// instant. Without a prior value, timestamps at midnight become dates.
+func dateTimeValue(value string, prior types.String) types.String {
+	apiTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return dateValue(value, prior)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if len(prior.ValueString()) == len(time.DateOnly) {
+			return dateValue(value, prior)
+		}
+		if priorTime, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && priorTime.Equal(apiTime) {
+			return prior
+		}
+	} else if apiTime.Hour() == 0 && apiTime.Minute() == 0 && apiTime.Second() == 0 {
+		return dateValue(value, prior)
+	}
+
+	return types.StringValue(value)
+}
+
+// updateStringPointer returns the value of an optional attribute for a
+// request that only sends set fields. An attribute that was set in prior but
+// is now unset is sent as an empty string, which Firefly III stores as null,
+// so that removing it from the configuration clears it.
+func updateStringPointer(value, prior types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		if prior.IsNull() || prior.IsUnknown() {
+			return nil
+		}
+		return new(string)
+	}
+
+	return value.ValueStringPointer()
+}
+
+// stringValueOf returns the string s points to, or an empty string when s is
+// nil.
+func stringValueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// nullableStringValue converts an API string into a state value for a
+// computed attribute, where an empty value means the field is not set.
+func nullableStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// planReference plans a pair of computed attributes that refer to the same
+// object by ID and by name, of which at most one is configured. The other one
+// is taken from state while the reference is unchanged and unknown otherwise.
+// It reports false when neither is configured.
+func planReference(configID, configName, stateID, stateName types.String) (types.String, types.String, bool) {
+	switch {
+	case !configID.IsNull():
+		if configID.Equal(stateID) {
+			return configID, stateName, true
+		}
+		return configID, types.StringUnknown(), true
+	case !configName.IsNull():
+		if configName.Equal(stateName) {
+			return stateID, configName, true
+		}
+		return types.StringUnknown(), configName, true
+	}
+
+	return types.StringNull(), types.StringNull(), false
+}
+
+// knownString returns the value of s, or an empty string when s is unknown.
+func knownString(s types.String) string {
+	if s.IsUnknown() {
+		return ""
+	}
+
+	return s.ValueString()
+}
+
+// ownerRequiredError converts an API error of an endpoint that requires the
+// owner role into diagnostics, explaining the most likely cause of permission
+// errors.
+func ownerRequiredError(action, thing string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client.IsForbidden(err) {
+		diags.AddError(
+			"Insufficient Permissions",
+			fmt.Sprintf("Unable to %s %s: the configured api_key does not belong to an owner of the Firefly III instance. "+
+				"This requires the owner role. Got error: %s", action, thing, err),
+		)
+		return diags
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s %s, got error: %s", action, thing, err))
+	return diags
+}
+
+// versionAtLeast reports whether the Firefly III version is at least minimum,
+// comparing major, minor and patch numbers. It reports false as second value
+// when version cannot be parsed, such as for development builds.
+func versionAtLeast(version, minimum string) (bool, bool) {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false, false
+	}
+	m, ok := parseVersion(minimum)
+	if !ok {
+		return false, false
+	}
+
+	for i := range v {
+		if v[i] != m[i] {
+			return v[i] > m[i], true
+		}
+	}
+
+	return true, true
+}
+
+// parseVersion parses a version such as "v6.1.24" into its major, minor and
+// patch numbers. Pre-release and build suffixes are ignored.
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) > len(parsed) {
+		return parsed, false
+	}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return parsed, false
+		}
+		parsed[i] = number
+	}
+
+	return parsed, true
+}
+
+// userGroupIDAttribute is the user_group_id attribute of resources that hold
+// financial data, which scopes the resource to a user group. The user group
+// is kept in state, so changing the user_group_id of the provider does not
+// move existing resources.
+func userGroupIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "The ID of the user group (financial administration) the resource belongs to. " +
+			"Defaults to the `user_group_id` of the provider when the resource is created or imported, and is kept when that changes later. " +
+			"Stays unset when neither is configured, in which case the current user group of the user is used. " +
+			"Setting this to a different user group forces a new resource.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !req.ConfigValue.IsNull()
+				},
+				"Setting a different user group forces a new resource.",
+				"Setting a different user group forces a new resource.",
+			),
+		},
+	}
+}
+
+// userGroupIDDataSourceAttribute is the user_group_id attribute of data
+// sources that read financial data.
+func userGroupIDDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "The ID of the user group (financial administration) to read from. Defaults to the `user_group_id` of the provider. " +
+			"Stays unset when neither is configured, in which case the current user group of the user is used.",
+	}
+}
+
+// userGroupClient returns c scoped to the user group of a resource or data
+// source. An unset user group defaults to the user group of the provider,
+// which is stored in userGroupID so that it ends up in state.
+func userGroupClient(c *client.Client, userGroupID *types.String) *client.Client {
+	scoped := c.WithUserGroup(userGroupID.ValueString())
+	*userGroupID = nullableStringValue(scoped.UserGroupID)
+	return scoped
+}
+
+// importUserGroupID splits an import identifier of the form user_group_id:id,
+// storing the user group in state, and returns the remaining ID. Identifiers
+// without a numeric user group prefix are returned unchanged.
+func importUserGroupID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) string {
+	userGroupID, id, ok := strings.Cut(req.ID, ":")
+	if !ok || id == "" {
+		return req.ID
+	}
+	if _, err := strconv.ParseUint(userGroupID, 10, 64); err != nil {
+		return req.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_group_id"), userGroupID)...)
+	return id
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDecimalValue(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		prior types.String
+		want  types.String
+	}{
+		"formatted equal":      {value: "600.00", prior: types.StringValue("600"), want: types.StringValue("600")},
+		"changed":              {value: "600.00", prior: types.StringValue("500"), want: types.StringValue("600.00")},
+		"no prior":             {value: "600.00", prior: types.StringNull(), want: types.StringValue("600.00")},
+		"unknown prior":        {value: "600.00", prior: types.StringUnknown(), want: types.StringValue("600.00")},
+		"empty without prior":  {value: "", prior: types.StringNull(), want: types.StringNull()},
+		"zero without prior":   {value: "0.00", prior: types.StringNull(), want: types.StringNull()},
+		"zero with prior zero": {value: "0.00", prior: types.StringValue("0"), want: types.StringValue("0")},
+		"not a number":         {value: "abc", prior: types.StringValue("1"), want: types.StringValue("abc")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := decimalValue(test.value, test.prior); !got.Equal(test.want) {
+				t.Errorf("decimalValue(%q, %s) = %s, want %s", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDateValue(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		prior types.String
+		want  types.String
+	}{
+		"date":                {value: "2026-01-31", prior: types.StringNull(), want: types.StringValue("2026-01-31")},
+		"timestamp":           {value: "2026-01-31T00:00:00+01:00", prior: types.StringNull(), want: types.StringValue("2026-01-31")},
+		"empty without prior": {value: "", prior: types.StringNull(), want: types.StringNull()},
+		"empty with prior":    {value: "", prior: types.StringValue(""), want: types.StringValue("")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := dateValue(test.value, test.prior); !got.Equal(test.want) {
+				t.Errorf("dateValue(%q, %s) = %s, want %s", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDateTimeValue(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		prior types.String
+		want  types.String
+	}{
+		"configured date": {
+			value: "2026-01-31T00:00:00+01:00",
+			prior: types.StringValue("2026-01-31"),
+			want:  types.StringValue("2026-01-31"),
+		},
+		"configured timestamp in other zone": {
+			value: "2026-01-31T13:00:00+01:00",
+			prior: types.StringValue("2026-01-31T12:00:00Z"),
+			want:  types.StringValue("2026-01-31T12:00:00Z"),
+		},
+		"changed timestamp": {
+			value: "2026-01-31T13:00:00+01:00",
+			prior: types.StringValue("2026-01-31T13:00:00Z"),
+			want:  types.StringValue("2026-01-31T13:00:00+01:00"),
+		},
+		"imported midnight": {
+			value: "2026-01-31T00:00:00+01:00",
+			prior: types.StringNull(),
+			want:  types.StringValue("2026-01-31"),
+		},
+		"imported timestamp": {
+			value: "2026-01-31T13:00:00+01:00",
+			prior: types.StringNull(),
+			want:  types.StringValue("2026-01-31T13:00:00+01:00"),
+		},
+		"date only": {
+			value: "2026-01-31",
+			prior: types.StringNull(),
+			want:  types.StringValue("2026-01-31"),
+		},
+		"empty": {
+			value: "",
+			prior: types.StringNull(),
+			want:  types.StringNull(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := dateTimeValue(test.value, test.prior); !got.Equal(test.want) {
+				t.Errorf("dateTimeValue(%q, %s) = %s, want %s", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlanReference(t *testing.T) {
+	null := types.StringNull()
+	unknown := types.StringUnknown()
+
+	tests := map[string]struct {
+		configID, configName, stateID, stateName types.String
+		wantID, wantName                         types.String
+		wantOK                                   bool
+	}{
+		"id unchanged": {
+			configID: types.StringValue("1"), configName: null,
+			stateID: types.StringValue("1"), stateName: types.StringValue("Housing"),
+			wantID: types.StringValue("1"), wantName: types.StringValue("Housing"), wantOK: true,
+		},
+		"id changed": {
+			configID: types.StringValue("2"), configName: null,
+			stateID: types.StringValue("1"), stateName: types.StringValue("Housing"),
+			wantID: types.StringValue("2"), wantName: unknown, wantOK: true,
+		},
+		"name unchanged": {
+			configID: null, configName: types.StringValue("Housing"),
+			stateID: types.StringValue("1"), stateName: types.StringValue("Housing"),
+			wantID: types.StringValue("1"), wantName: types.StringValue("Housing"), wantOK: true,
+		},
+		"name changed": {
+			configID: null, configName: types.StringValue("Travel"),
+			stateID: types.StringValue("1"), stateName: types.StringValue("Housing"),
+			wantID: unknown, wantName: types.StringValue("Travel"), wantOK: true,
+		},
+		"name on create": {
+			configID: null, configName: types.StringValue("Housing"),
+			stateID: null, stateName: null,
+			wantID: unknown, wantName: types.StringValue("Housing"), wantOK: true,
+		},
+		"neither": {
+			configID: null, configName: null,
+			stateID: types.StringValue("1"), stateName: types.StringValue("Housing"),
+			wantID: null, wantName: null, wantOK: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotID, gotName, gotOK := planReference(test.configID, test.configName, test.stateID, test.stateName)
+			if !gotID.Equal(test.wantID) || !gotName.Equal(test.wantName) || gotOK != test.wantOK {
+				t.Errorf("planReference() = (%s, %s, %t), want (%s, %s, %t)", gotID, gotName, gotOK, test.wantID, test.wantName, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestUpdateStringPointer(t *testing.T) {
+	tests := map[string]struct {
+		value, prior types.String
+		want         *string
+	}{
+		"set":           {value: types.StringValue("100"), prior: types.StringNull(), want: stringPointer("100")},
+		"unset":         {value: types.StringNull(), prior: types.StringNull(), want: nil},
+		"cleared":       {value: types.StringNull(), prior: types.StringValue("100"), want: stringPointer("")},
+		"unknown":       {value: types.StringUnknown(), prior: types.StringNull(), want: nil},
+		"unknown prior": {value: types.StringNull(), prior: types.StringUnknown(), want: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := updateStringPointer(test.value, test.prior)
+			if (got == nil) != (test.want == nil) || (got != nil && *got != *test.want) {
+				t.Errorf("updateStringPointer(%s, %s) = %v, want %v", test.value, test.prior, got, test.want)
+			}
+		})
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := map[string]struct {
+		version, minimum string
+		want, wantOK     bool
+	}{
+		"equal":            {version: "6.1.0", minimum: "6.1.0", want: true, wantOK: true},
+		"newer patch":      {version: "6.1.24", minimum: "6.1.0", want: true, wantOK: true},
+		"newer major":      {version: "7.0.0", minimum: "6.1.0", want: true, wantOK: true},
+		"older minor":      {version: "6.0.30", minimum: "6.1.0", want: false, wantOK: true},
+		"prefix and build": {version: "v6.2.1-beta.1", minimum: "6.1.0", want: true, wantOK: true},
+		"short":            {version: "6", minimum: "6.1.0", want: false, wantOK: true},
+		"development":      {version: "develop/2026-01-31", minimum: "6.1.0", wantOK: false},
+		"empty":            {version: "", minimum: "6.1.0", wantOK: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, gotOK := versionAtLeast(test.version, test.minimum)
+			if got != test.want || gotOK != test.wantOK {
+				t.Errorf("versionAtLeast(%q, %q) = (%t, %t), want (%t, %t)", test.version, test.minimum, got, gotOK, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+// testModifyPlan calls the ModifyPlan method of r with the given config, state
+// and plan, and returns the resulting plan. A nil state plans a create and a
+// nil plan a destroy.
+func testModifyPlan[T any](t *testing.T, r resource.ResourceWithModifyPlan, config, state, plan *T) (*T, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	raw := func(model *T) tftypes.Value {
+		value := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		if model != nil {
+			if diags := value.Set(ctx, model); diags.HasError() {
+				t.Fatalf("unable to build test value: %v", diags)
+			}
+		}
+		return value.Raw
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: raw(config)},
+		State:  tfsdk.State{Schema: s, Raw: raw(state)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: raw(plan)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+
+	var got T
+	if !resp.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	}
+
+	return &got, resp.Diagnostics
+}
+
+func TestImportUserGroupID(t *testing.T) {
+	ctx := context.Background()
+	importSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"user_group_id": userGroupIDAttribute(),
+		},
+	}
+
+	tests := map[string]struct {
+		id              string
+		wantID          string
+		wantUserGroupID types.String
+	}{
+		"plain id":          {id: "15", wantID: "15", wantUserGroupID: types.StringNull()},
+		"user group prefix": {id: "2:15", wantID: "15", wantUserGroupID: types.StringValue("2")},
+		"compound id":       {id: "2:3/4", wantID: "3/4", wantUserGroupID: types.StringValue("2")},
+		"non-numeric":       {id: "abc:15", wantID: "abc:15", wantUserGroupID: types.StringNull()},
+		"missing id":        {id: "2:", wantID: "2:", wantUserGroupID: types.StringNull()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: importSchema,
+					Raw:    tftypes.NewValue(importSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			got := importUserGroupID(ctx, resource.ImportStateRequest{ID: test.id}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got != test.wantID {
+				t.Errorf("importUserGroupID(%q) = %q, want %q", test.id, got, test.wantID)
+			}
+
+			var userGroupID types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("user_group_id"), &userGroupID)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !userGroupID.Equal(test.wantUserGroupID) {
+				t.Errorf("user_group_id = %s, want %s", userGroupID, test.wantUserGroupID)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &LinkTypeResource{}
+var _ resource.ResourceWithImportState = &LinkTypeResource{}
+var _ resource.ResourceWithModifyPlan = &LinkTypeResource{}
+
+func NewLinkTypeResource() resource.Resource {
+	return &LinkTypeResource{}
+}
+
+type LinkTypeResource struct {
+	client *client.Client
+}
+
+type LinkTypeResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Inward   types.String `tfsdk:"inward"`
+	Outward  types.String `tfsdk:"outward"`
+	Editable types.Bool   `tfsdk:"editable"`
+}
+
+func (r *LinkTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_type"
+}
+
+func (r *LinkTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III link type. Link types describe how two linked transactions relate to each other.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the link type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/LinkType/StoreRequest.php
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the link type. Must be unique and at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"inward": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The description of the link seen from the inward transaction (e.g., `is reimbursed by`). Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"outward": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The description of the link seen from the outward transaction (e.g., `reimburses`). Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"editable": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the link type can be changed. The link types Firefly III ships with are not editable.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LinkTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects changes to link types that are not editable, so they
+// fail during plan instead of with an API error during apply.
+func (r *LinkTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan LinkTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkLinkTypeEditable(&state, &plan)...)
+}
+
+func (r *LinkTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LinkTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkType := r.modelToAPILinkType(&data)
+
+	createdLinkType, err := r.client.CreateLinkType(ctx, linkType)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create link type, got error: %s", err))
+		return
+	}
+
+	r.apiLinkTypeToModel(createdLinkType, &data)
+
+	tflog.Trace(ctx, "created a link type resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LinkTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LinkTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkType, err := r.client.GetLinkType(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Link type not found", fmt.Sprintf("Link type %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read link type, got error: %s", err))
+		return
+	}
+
+	r.apiLinkTypeToModel(linkType, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LinkTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state LinkTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkLinkTypeEditable(&state, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkType := r.modelToAPILinkType(&data)
+
+	updatedLinkType, err := r.client.UpdateLinkType(ctx, data.ID.ValueString(), linkType)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update link type, got error: %s", err))
+		return
+	}
+
+	r.apiLinkTypeToModel(updatedLinkType, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the link type. Link types that are not editable cannot be
+// deleted, so they are only removed from the Terraform state.
+func (r *LinkTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LinkTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Editable.IsNull() && !data.Editable.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Link type not deleted",
+			fmt.Sprintf("Link type %q is built into Firefly III and cannot be deleted. It has only been removed from the Terraform state.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.DeleteLinkType(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete link type, got error: %s", err))
+		return
+	}
+}
+
+func (r *LinkTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkLinkTypeEditable returns an error when plan changes a link type that
+// is not editable.
+func checkLinkTypeEditable(state, plan *LinkTypeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.Editable.IsNull() || state.Editable.ValueBool() {
+		return diags
+	}
+
+	for _, attr := range []struct {
+		name        string
+		state, plan types.String
+	}{
+		{"name", state.Name, plan.Name},
+		{"inward", state.Inward, plan.Inward},
+		{"outward", state.Outward, plan.Outward},
+	} {
+		if !attr.plan.Equal(attr.state) {
+			diags.AddAttributeError(
+				path.Root(attr.name),
+				"Link Type Not Editable",
+				fmt.Sprintf("Link type %q is built into Firefly III and cannot be changed. Define a new firefly3_link_type instead.", state.Name.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *LinkTypeResource) modelToAPILinkType(data *LinkTypeResourceModel) *client.LinkType {
+	return &client.LinkType{
+		Name:    data.Name.ValueString(),
+		Inward:  data.Inward.ValueString(),
+		Outward: data.Outward.ValueString(),
+	}
+}
+
+func (r *LinkTypeResource) apiLinkTypeToModel(linkType *client.LinkType, data *LinkTypeResourceModel) {
+	data.ID = types.StringValue(linkType.ID)
+	data.Name = types.StringValue(linkType.Name)
+	data.Inward = types.StringValue(linkType.Inward)
+	data.Outward = types.StringValue(linkType.Outward)
+	data.Editable = types.BoolValue(linkType.Editable)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLinkTypeResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkTypeResourceConfig(name, "reimburses"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_link_type.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_link_type.test", "name", name),
+					resource.TestCheckResourceAttr("firefly3_link_type.test", "outward", "reimburses"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_link_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLinkTypeResourceConfig(name, "pays back"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_link_type.test", "outward", "pays back"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkTypeResourceConfig(name, outward string) string {
+	return fmt.Sprintf(`
+resource "firefly3_link_type" "test" {
+  name    = %q
+  inward  = "is reimbursed by"
+  outward = %q
+}
+`, name, outward)
+}
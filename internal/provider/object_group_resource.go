@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &ObjectGroupResource{}
+var _ resource.ResourceWithImportState = &ObjectGroupResource{}
+
+func NewObjectGroupResource() resource.Resource {
+	return &ObjectGroupResource{}
+}
+
+type ObjectGroupResource struct {
+	client *client.Client
+}
+
+type ObjectGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	Title       types.String `tfsdk:"title"`
+	Order       types.Int32  `tfsdk:"order"`
+}
+
+func (r *ObjectGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_group"
+}
+
+func (r *ObjectGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III object group. Object groups group bills and piggy banks in the overviews. " +
+			"The Firefly III API cannot create object groups, so this resource adopts the existing group with the configured title, which Firefly III creates when a bill or piggy bank is assigned to it. " +
+			"Destroying the resource deletes the group.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the object group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/ObjectGroup/UpdateRequest.php
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title of the object group. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"order": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The order of the object group in the overviews.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ObjectGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adopts the existing object group with the configured title. The
+// Firefly III API has no endpoint to create object groups.
+func (r *ObjectGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	objectGroups, err := apiClient.ListObjectGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list object groups, got error: %s", err))
+		return
+	}
+
+	var existingObjectGroup *client.ObjectGroup
+	for i := range objectGroups {
+		if objectGroups[i].Title == data.Title.ValueString() {
+			existingObjectGroup = &objectGroups[i]
+			break
+		}
+	}
+
+	if existingObjectGroup == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"Object Group Not Found",
+			fmt.Sprintf("No object group with title %q exists. Firefly III only creates object groups when a bill or piggy bank is assigned to them, "+
+				"so set object_group_title on a bill or piggy bank first and make this resource depend on it.", data.Title.ValueString()),
+		)
+		return
+	}
+
+	objectGroup := r.modelToAPIObjectGroup(&data, existingObjectGroup)
+
+	createdObjectGroup, err := apiClient.UpdateObjectGroup(ctx, existingObjectGroup.ID, objectGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create object group, got error: %s", err))
+		return
+	}
+
+	r.apiObjectGroupToModel(createdObjectGroup, &data)
+
+	tflog.Trace(ctx, "created an object group resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	objectGroup, err := apiClient.GetObjectGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Object group not found", fmt.Sprintf("Object group %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read object group, got error: %s", err))
+		return
+	}
+
+	r.apiObjectGroupToModel(objectGroup, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObjectGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	objectGroup := r.modelToAPIObjectGroup(&data, &client.ObjectGroup{Order: state.Order.ValueInt32()})
+
+	updatedObjectGroup, err := apiClient.UpdateObjectGroup(ctx, data.ID.ValueString(), objectGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update object group, got error: %s", err))
+		return
+	}
+
+	r.apiObjectGroupToModel(updatedObjectGroup, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the adopted object group. Firefly III keeps the bills and
+// piggy banks in it, without a group.
+func (r *ObjectGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteObjectGroup(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object group, got error: %s", err))
+		return
+	}
+}
+
+func (r *ObjectGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// modelToAPIObjectGroup converts the model into an API object group. The order
+// of existing is kept when no order is configured.
+func (r *ObjectGroupResource) modelToAPIObjectGroup(data *ObjectGroupResourceModel, existing *client.ObjectGroup) *client.ObjectGroup {
+	objectGroup := &client.ObjectGroup{
+		Title: data.Title.ValueString(),
+		Order: existing.Order,
+	}
+
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
+		objectGroup.Order = data.Order.ValueInt32()
+	}
+
+	return objectGroup
+}
+
+func (r *ObjectGroupResource) apiObjectGroupToModel(objectGroup *client.ObjectGroup, data *ObjectGroupResourceModel) {
+	data.ID = types.StringValue(objectGroup.ID)
+	data.Title = types.StringValue(objectGroup.Title)
+	data.Order = types.Int32Value(objectGroup.Order)
+}
+
+// modifyObjectGroupPlan plans the object_group_id and object_group_title
+// attributes of bills and piggy banks. Only one of them can be configured;
+// the other is known from state while the group stays the same, and unknown
+// when the group changes. Without either, the resource has no group.
+func modifyObjectGroupPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configID, configTitle, stateID, stateTitle types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("object_group_id"), &configID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("object_group_title"), &configTitle)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("object_group_id"), &stateID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("object_group_title"), &stateTitle)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planID, planTitle, _ := planReference(configID, configTitle, stateID, stateTitle)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("object_group_id"), planID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("object_group_title"), planTitle)...)
+}
+
+// objectGroupReference returns the object_group_id and object_group_title of
+// a bill or piggy bank to send to the API. Only the configured attribute is
+// sent: Firefly III resolves the title before the ID, so a title taken from
+// state would keep the object in its old group. An empty title removes the
+// object from its group.
+func objectGroupReference(ctx context.Context, config tfsdk.Config) (string, string, diag.Diagnostics) {
+	var id, title types.String
+
+	diags := config.GetAttribute(ctx, path.Root("object_group_id"), &id)
+	diags.Append(config.GetAttribute(ctx, path.Root("object_group_title"), &title)...)
+
+	return knownString(id), knownString(title), diags
+}
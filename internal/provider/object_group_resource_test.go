@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestModifyObjectGroupPlan(t *testing.T) {
+	bill := func(id, title types.String) *BillResourceModel {
+		return &BillResourceModel{ID: types.StringValue("7"), Name: types.StringValue("Rent"), ObjectGroupID: id, ObjectGroupTitle: title}
+	}
+	null, unknown := types.StringNull(), types.StringUnknown()
+	housing, travel := types.StringValue("Housing"), types.StringValue("Travel")
+
+	tests := map[string]struct {
+		config, state     *BillResourceModel
+		wantID, wantTitle types.String
+	}{
+		"create with title": {
+			config: bill(null, housing),
+			wantID: unknown, wantTitle: housing,
+		},
+		"unchanged title": {
+			config: bill(null, housing), state: bill(types.StringValue("1"), housing),
+			wantID: types.StringValue("1"), wantTitle: housing,
+		},
+		"changed title": {
+			config: bill(null, travel), state: bill(types.StringValue("1"), housing),
+			wantID: unknown, wantTitle: travel,
+		},
+		"changed id": {
+			config: bill(types.StringValue("2"), null), state: bill(types.StringValue("1"), housing),
+			wantID: types.StringValue("2"), wantTitle: unknown,
+		},
+		"removed": {
+			config: bill(null, null), state: bill(types.StringValue("1"), housing),
+			wantID: null, wantTitle: null,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := bill(unknown, unknown)
+			if !test.config.ObjectGroupID.IsNull() {
+				plan.ObjectGroupID = test.config.ObjectGroupID
+			}
+			if !test.config.ObjectGroupTitle.IsNull() {
+				plan.ObjectGroupTitle = test.config.ObjectGroupTitle
+			}
+
+			got, diags := testModifyPlan(t, &BillResource{}, test.config, test.state, plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.ObjectGroupID.Equal(test.wantID) || !got.ObjectGroupTitle.Equal(test.wantTitle) {
+				t.Errorf("object group = (%s, %s), want (%s, %s)", got.ObjectGroupID, got.ObjectGroupTitle, test.wantID, test.wantTitle)
+			}
+		})
+	}
+}
+
+func TestAccObjectGroupResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectGroupResourceConfig(name, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("firefly3_object_group.test", "id", "firefly3_bill.grouped", "object_group_id"),
+					resource.TestCheckResourceAttr("firefly3_object_group.test", "title", name+" group"),
+					resource.TestCheckResourceAttr("firefly3_object_group.test", "order", "1"),
+					resource.TestCheckResourceAttrPair("firefly3_piggy_bank.test", "object_group_id", "firefly3_object_group.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_piggy_bank.test", "object_group_title", name+" group"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_object_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObjectGroupResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_object_group.test", "order", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectGroupResourceConfig(name string, order int) string {
+	return testAccAssetAccountConfig(name) + fmt.Sprintf(`
+resource "firefly3_bill" "grouped" {
+  name               = "%[3]s bill"
+  amount_min         = "1200"
+  amount_max         = "1200"
+  date               = "2026-01-01"
+  repeat_freq        = "monthly"
+  object_group_title = %[1]q
+}
+
+resource "firefly3_object_group" "test" {
+  title = %[1]q
+  order = %[2]d
+
+  depends_on = [firefly3_bill.grouped]
+}
+
+resource "firefly3_piggy_bank" "test" {
+  name            = "%[3]s piggy bank"
+  account_ids     = [firefly3_account.asset.id]
+  target_amount   = "1000"
+  object_group_id = firefly3_object_group.test.id
+}
+`, name+" group", order, name)
+}
@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &PiggyBankResource{}
+var _ resource.ResourceWithImportState = &PiggyBankResource{}
+var _ resource.ResourceWithModifyPlan = &PiggyBankResource{}
+
+func NewPiggyBankResource() resource.Resource {
+	return &PiggyBankResource{}
+}
+
+type PiggyBankResource struct {
+	client *client.Client
+}
+
+type PiggyBankResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserGroupID      types.String `tfsdk:"user_group_id"`
+	Name             types.String `tfsdk:"name"`
+	AccountIDs       types.List   `tfsdk:"account_ids"`
+	TargetAmount     types.String `tfsdk:"target_amount"`
+	CurrentAmount    types.String `tfsdk:"current_amount"`
+	SavedAmount      types.String `tfsdk:"saved_amount"`
+	StartDate        types.String `tfsdk:"start_date"`
+	TargetDate       types.String `tfsdk:"target_date"`
+	Order            types.Int32  `tfsdk:"order"`
+	Notes            types.String `tfsdk:"notes"`
+	ObjectGroupID    types.String `tfsdk:"object_group_id"`
+	ObjectGroupTitle types.String `tfsdk:"object_group_title"`
+}
+
+func (r *PiggyBankResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_piggy_bank"
+}
+
+func (r *PiggyBankResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III piggy bank. Piggy banks track money saved towards a goal in one or more asset accounts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the piggy bank.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/PiggyBank/StoreRequest.php
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the piggy bank. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"account_ids": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the asset accounts the money is saved in.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"target_amount": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The amount to save, as a decimal string. Leave empty for a piggy bank without a target.",
+			},
+			"current_amount": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The amount already saved when the piggy bank is created, as a decimal string. It is put in the first account of `account_ids`. " +
+					"This is only used on creation: changing it later has no effect, and deposits and withdrawals made in Firefly III are not reverted. See `saved_amount` for the current total.",
+			},
+			"saved_amount": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The amount currently saved in the piggy bank, including all deposits and withdrawals.",
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The date saving started, formatted as `YYYY-MM-DD`. Defaults to the creation date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date by which the target amount should be saved, formatted as `YYYY-MM-DD`.",
+			},
+			"order": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The order of the piggy bank in the overview.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the piggy bank.",
+			},
+			"object_group_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the object group the piggy bank is shown in, for example `firefly3_object_group.example.id`. Conflicts with `object_group_title`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("object_group_title")),
+				},
+			},
+			"object_group_title": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The title of the object group the piggy bank is shown in. The group is created if it does not exist yet. Conflicts with `object_group_id`.",
+			},
+		},
+	}
+}
+
+func (r *PiggyBankResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the object group attributes, see modifyObjectGroupPlan.
+func (r *PiggyBankResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyObjectGroupPlan(ctx, req, resp)
+}
+
+func (r *PiggyBankResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PiggyBankResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	piggyBank, diags := r.modelToAPIPiggyBank(ctx, &data, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	piggyBank.ObjectGroupID, piggyBank.ObjectGroupTitle, diags = objectGroupReference(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The initial amount is saved in the first account.
+	if !data.CurrentAmount.IsNull() {
+		piggyBank.Accounts[0].CurrentAmount = data.CurrentAmount.ValueString()
+	}
+
+	createdPiggyBank, err := apiClient.CreatePiggyBank(ctx, piggyBank)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create piggy bank, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPiggyBankToModel(ctx, createdPiggyBank, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a piggy bank resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PiggyBankResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PiggyBankResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	piggyBank, err := apiClient.GetPiggyBank(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Piggy bank not found", fmt.Sprintf("Piggy bank %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read piggy bank, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPiggyBankToModel(ctx, piggyBank, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PiggyBankResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PiggyBankResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	// The API replaces the linked accounts including their saved amounts, so
+	// the amounts currently saved are sent back to keep the piggy bank events
+	// made in Firefly III intact.
+	current, err := apiClient.GetPiggyBank(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read piggy bank, got error: %s", err))
+		return
+	}
+
+	piggyBank, diags := r.modelToAPIPiggyBank(ctx, &data, current.Accounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	piggyBank.ObjectGroupID, piggyBank.ObjectGroupTitle, diags = objectGroupReference(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedPiggyBank, err := apiClient.UpdatePiggyBank(ctx, data.ID.ValueString(), piggyBank)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update piggy bank, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPiggyBankToModel(ctx, updatedPiggyBank, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PiggyBankResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PiggyBankResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeletePiggyBank(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete piggy bank, got error: %s", err))
+		return
+	}
+}
+
+func (r *PiggyBankResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// modelToAPIPiggyBank converts the model into an API piggy bank. The saved
+// amount of every account that is already linked is taken from existing.
+func (r *PiggyBankResource) modelToAPIPiggyBank(ctx context.Context, data *PiggyBankResourceModel, existing []client.PiggyBankAccount) (*client.PiggyBank, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	piggyBank := &client.PiggyBank{
+		Name:         data.Name.ValueString(),
+		TargetAmount: data.TargetAmount.ValueStringPointer(),
+		TargetDate:   data.TargetDate.ValueStringPointer(),
+		Notes:        data.Notes.ValueString(),
+	}
+
+	if !data.StartDate.IsNull() && !data.StartDate.IsUnknown() {
+		piggyBank.StartDate = data.StartDate.ValueString()
+	}
+
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
+		piggyBank.Order = data.Order.ValueInt32()
+	}
+
+	var accountIDs []string
+	diags.Append(data.AccountIDs.ElementsAs(ctx, &accountIDs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	piggyBank.Accounts = make([]client.PiggyBankAccount, len(accountIDs))
+	for i, accountID := range accountIDs {
+		piggyBank.Accounts[i] = client.PiggyBankAccount{AccountID: accountID}
+
+		for _, account := range existing {
+			if account.AccountID == accountID {
+				piggyBank.Accounts[i].CurrentAmount = account.CurrentAmount
+			}
+		}
+	}
+
+	return piggyBank, diags
+}
+
+func (r *PiggyBankResource) apiPiggyBankToModel(ctx context.Context, piggyBank *client.PiggyBank, data *PiggyBankResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(piggyBank.ID)
+	data.Name = types.StringValue(piggyBank.Name)
+	data.TargetAmount = decimalValue(stringValueOf(piggyBank.TargetAmount), data.TargetAmount)
+	data.SavedAmount = types.StringValue(piggyBank.CurrentAmount)
+	data.StartDate = dateValue(piggyBank.StartDate, data.StartDate)
+	data.TargetDate = dateValue(stringValueOf(piggyBank.TargetDate), data.TargetDate)
+	data.Order = types.Int32Value(piggyBank.Order)
+	data.Notes = optionalStringValue(piggyBank.Notes, data.Notes)
+	data.ObjectGroupID = nullableStringValue(piggyBank.ObjectGroupID)
+	data.ObjectGroupTitle = nullableStringValue(piggyBank.ObjectGroupTitle)
+
+	// current_amount is deliberately left untouched: it only describes the
+	// amount saved on creation, so changing it later only updates state.
+
+	accountIDs := make([]string, len(piggyBank.Accounts))
+	for i, account := range piggyBank.Accounts {
+		accountIDs[i] = account.AccountID
+	}
+
+	// Keep the configured order when the same accounts are linked, as the
+	// first account receives the initial amount.
+	var priorIDs []string
+	if !data.AccountIDs.IsNull() && !data.AccountIDs.IsUnknown() {
+		diags.Append(data.AccountIDs.ElementsAs(ctx, &priorIDs, false)...)
+	}
+	if len(priorIDs) == len(accountIDs) && !slices.ContainsFunc(accountIDs, func(id string) bool { return !slices.Contains(priorIDs, id) }) {
+		accountIDs = priorIDs
+	}
+
+	accountIDsValue, d := types.ListValueFrom(ctx, types.StringType, accountIDs)
+	diags.Append(d...)
+	data.AccountIDs = accountIDsValue
+
+	return diags
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPiggyBankResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPiggyBankResourceConfig(name, "15000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_piggy_bank.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_piggy_bank.test", "name", name),
+					resource.TestCheckResourceAttr("firefly3_piggy_bank.test", "target_amount", "15000"),
+					resource.TestCheckResourceAttr("firefly3_piggy_bank.test", "current_amount", "250"),
+					resource.TestCheckResourceAttrPair("firefly3_piggy_bank.test", "account_ids.0", "firefly3_account.asset", "id"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_piggy_bank.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The initial amount is only used when the piggy bank is created.
+				ImportStateVerifyIgnore: []string{"current_amount"},
+			},
+			{
+				Config: testAccPiggyBankResourceConfig(name, "16000.00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_piggy_bank.test", "target_amount", "16000.00"),
+				),
+			},
+			{
+				Config: testAccAssetAccountConfig(name) + fmt.Sprintf(`
+resource "firefly3_piggy_bank" "test" {
+  name        = %q
+  account_ids = [firefly3_account.asset.id]
+  start_date  = "2026-01-01"
+}
+`, name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("firefly3_piggy_bank.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("firefly3_piggy_bank.test", "target_amount"),
+					resource.TestCheckNoResourceAttr("firefly3_piggy_bank.test", "target_date"),
+					resource.TestCheckNoResourceAttr("firefly3_piggy_bank.test", "current_amount"),
+					resource.TestCheckResourceAttrSet("firefly3_piggy_bank.test", "saved_amount"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPiggyBankResourceConfig(name, targetAmount string) string {
+	return testAccAssetAccountConfig(name) + fmt.Sprintf(`
+resource "firefly3_piggy_bank" "test" {
+  name           = %[1]q
+  account_ids    = [firefly3_account.asset.id]
+  target_amount  = %[2]q
+  current_amount = "250"
+  start_date     = "2026-01-01"
+  target_date    = "2028-12-31"
+}
+`, name, targetAmount)
+}
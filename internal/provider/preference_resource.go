@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &PreferenceResource{}
+var _ resource.ResourceWithImportState = &PreferenceResource{}
+
+func NewPreferenceResource() resource.Resource {
+	return &PreferenceResource{}
+}
+
+type PreferenceResource struct {
+	client *client.Client
+}
+
+type PreferenceResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Value       types.String         `tfsdk:"value"`
+	BoolValue   types.Bool           `tfsdk:"bool_value"`
+	NumberValue types.Float64        `tfsdk:"number_value"`
+	ValueJSON   jsontypes.Normalized `tfsdk:"value_json"`
+}
+
+func (r *PreferenceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preference"
+}
+
+func (r *PreferenceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	valueAttributes := []string{"value", "bool_value", "number_value", "value_json"}
+	exactlyOneValue := make([]path.Expression, len(valueAttributes))
+	for i, name := range valueAttributes {
+		exactlyOneValue[i] = path.MatchRoot(name)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III user preference, such as the language, the list page size or the start of the fiscal year. " +
+			"Exactly one of `value`, `bool_value`, `number_value` or `value_json` must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the preference, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the preference (e.g., `language`, `listPageSize` or `fiscalYearStart`). Changing this forces a new resource.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The value of a preference that is a string, such as `language` or `fiscalYearStart`.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(exactlyOneValue...),
+				},
+			},
+			"bool_value": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "The value of a preference that is a boolean, such as `customFiscalYear`.",
+			},
+			"number_value": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The value of a preference that is a number, such as `listPageSize`.",
+			},
+			"value_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				MarkdownDescription: "The JSON encoded value of a preference that is a list or an object, such as `frontpageAccounts`. " +
+					"Values are compared semantically, so formatting and key order do not cause differences.",
+			},
+		},
+	}
+}
+
+func (r *PreferenceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create sets the preference. Firefly III returns defaults for preferences
+// that were never set, so existing preferences are updated instead.
+func (r *PreferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PreferenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preference, diags := r.modelToAPIPreference(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetPreference(ctx, preference.Name)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read preference, got error: %s", err))
+		return
+	}
+
+	var createdPreference *client.Preference
+	if err == nil {
+		createdPreference, err = r.client.UpdatePreference(ctx, preference.Name, preference)
+	} else {
+		createdPreference, err = r.client.CreatePreference(ctx, preference)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create preference, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPreferenceToModel(createdPreference, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a preference resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PreferenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PreferenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preference, err := r.client.GetPreference(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Preference not found", fmt.Sprintf("Preference %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read preference, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPreferenceToModel(preference, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PreferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PreferenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preference, diags := r.modelToAPIPreference(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedPreference, err := r.client.UpdatePreference(ctx, data.ID.ValueString(), preference)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update preference, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiPreferenceToModel(updatedPreference, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the preference from the Terraform state, because the
+// Firefly III API cannot delete preferences. The preference keeps its value.
+func (r *PreferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PreferenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "preferences cannot be deleted, removing from state only", map[string]any{"name": data.Name.ValueString()})
+}
+
+func (r *PreferenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *PreferenceResource) modelToAPIPreference(data *PreferenceResourceModel) (*client.Preference, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var value any
+	switch {
+	case !data.Value.IsNull():
+		value = data.Value.ValueString()
+	case !data.BoolValue.IsNull():
+		value = data.BoolValue.ValueBool()
+	case !data.NumberValue.IsNull():
+		value = data.NumberValue.ValueFloat64()
+	default:
+		return &client.Preference{
+			Name: data.Name.ValueString(),
+			Data: json.RawMessage(data.ValueJSON.ValueString()),
+		}, diags
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Encoding Error", fmt.Sprintf("Unable to encode preference value, got error: %s", err))
+		return nil, diags
+	}
+
+	return &client.Preference{
+		Name: data.Name.ValueString(),
+		Data: encoded,
+	}, diags
+}
+
+// apiPreferenceToModel stores the value in the attribute that is set in the
+// plan or prior state. Firefly III casts values to the type it expects for a
+// preference, so a value of "50" may come back as a number. Only without a
+// prior value, such as on import, the JSON type decides the attribute.
+func (r *PreferenceResource) apiPreferenceToModel(preference *client.Preference, data *PreferenceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var value any
+	if err := json.Unmarshal(preference.Data, &value); err != nil {
+		diags.AddError("Decoding Error", fmt.Sprintf("Unable to decode value of preference %s, got error: %s", preference.Name, err))
+		return diags
+	}
+
+	prior := *data
+
+	data.ID = types.StringValue(preference.Name)
+	data.Name = types.StringValue(preference.Name)
+	data.Value = types.StringNull()
+	data.BoolValue = types.BoolNull()
+	data.NumberValue = types.Float64Null()
+	data.ValueJSON = jsontypes.NewNormalizedNull()
+
+	switch {
+	case !prior.Value.IsNull() && !prior.Value.IsUnknown():
+		switch v := value.(type) {
+		case string:
+			data.Value = types.StringValue(v)
+			return diags
+		case bool:
+			data.Value = types.StringValue(strconv.FormatBool(v))
+			return diags
+		case float64:
+			data.Value = types.StringValue(strconv.FormatFloat(v, 'f', -1, 64))
+			return diags
+		}
+	case !prior.BoolValue.IsNull() && !prior.BoolValue.IsUnknown():
+		switch v := value.(type) {
+		case bool:
+			data.BoolValue = types.BoolValue(v)
+			return diags
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				data.BoolValue = types.BoolValue(b)
+				return diags
+			}
+		}
+	case !prior.NumberValue.IsNull() && !prior.NumberValue.IsUnknown():
+		switch v := value.(type) {
+		case float64:
+			data.NumberValue = types.Float64Value(v)
+			return diags
+		case string:
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				data.NumberValue = types.Float64Value(f)
+				return diags
+			}
+		}
+	case !prior.ValueJSON.IsNull() && !prior.ValueJSON.IsUnknown():
+		data.ValueJSON = jsonValue(preference.Data)
+		return diags
+	}
+
+	switch v := value.(type) {
+	case string:
+		data.Value = types.StringValue(v)
+	case bool:
+		data.BoolValue = types.BoolValue(v)
+	case float64:
+		data.NumberValue = types.Float64Value(v)
+	default:
+		data.ValueJSON = jsonValue(preference.Data)
+	}
+
+	return diags
+}
+
+// jsonValue converts an API JSON value into a state value. The value is
+// compacted; a semantically equal value from the configuration is kept by the
+// normalized JSON type, so formatting and key order do not cause differences.
+func jsonValue(value json.RawMessage) jsontypes.Normalized {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, value); err != nil {
+		return jsontypes.NewNormalizedValue(string(value))
+	}
+
+	return jsontypes.NewNormalizedValue(compacted.String())
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+func TestJSONValue(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		config string
+		want   string
+		equal  bool
+	}{
+		"key order": {
+			value:  `{"b":2,"a":1}`,
+			config: `{"a": 1, "b": 2}`,
+			want:   `{"b":2,"a":1}`,
+			equal:  true,
+		},
+		"compacted": {
+			value:  "[1, 2, 3]",
+			config: "[1,2,3]",
+			want:   "[1,2,3]",
+			equal:  true,
+		},
+		"changed": {
+			value:  `{"a":1,"b":3}`,
+			config: `{"a": 1, "b": 2}`,
+			want:   `{"a":1,"b":3}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := jsonValue(json.RawMessage(test.value))
+			if !got.Equal(jsontypes.NewNormalizedValue(test.want)) {
+				t.Errorf("jsonValue(%s) = %s, want %s", test.value, got, test.want)
+			}
+
+			equal, diags := got.StringSemanticEquals(context.Background(), jsontypes.NewNormalizedValue(test.config))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != test.equal {
+				t.Errorf("jsonValue(%s) semantically equal to %s = %t, want %t", test.value, test.config, equal, test.equal)
+			}
+		})
+	}
+}
+
+func TestAPIPreferenceToModel(t *testing.T) {
+	tests := map[string]struct {
+		data  string
+		prior PreferenceResourceModel
+		want  PreferenceResourceModel
+	}{
+		"string cast to number": {
+			data:  "50",
+			prior: PreferenceResourceModel{Value: types.StringValue("50")},
+			want:  PreferenceResourceModel{Value: types.StringValue("50")},
+		},
+		"string cast to bool": {
+			data:  "true",
+			prior: PreferenceResourceModel{Value: types.StringValue("true")},
+			want:  PreferenceResourceModel{Value: types.StringValue("true")},
+		},
+		"bool returned as string": {
+			data:  `"1"`,
+			prior: PreferenceResourceModel{BoolValue: types.BoolValue(true)},
+			want:  PreferenceResourceModel{BoolValue: types.BoolValue(true)},
+		},
+		"number returned as string": {
+			data:  `"50"`,
+			prior: PreferenceResourceModel{NumberValue: types.Float64Value(50)},
+			want:  PreferenceResourceModel{NumberValue: types.Float64Value(50)},
+		},
+		"json": {
+			data:  `"nl_NL"`,
+			prior: PreferenceResourceModel{ValueJSON: jsontypes.NewNormalizedValue(`"nl_NL"`)},
+			want:  PreferenceResourceModel{ValueJSON: jsontypes.NewNormalizedValue(`"nl_NL"`)},
+		},
+		"import string": {
+			data: `"nl_NL"`,
+			want: PreferenceResourceModel{Value: types.StringValue("nl_NL")},
+		},
+		"import number": {
+			data: "50",
+			want: PreferenceResourceModel{NumberValue: types.Float64Value(50)},
+		},
+		"import object": {
+			data: `{"a": 1}`,
+			want: PreferenceResourceModel{ValueJSON: jsontypes.NewNormalizedValue(`{"a":1}`)},
+		},
+	}
+
+	r := &PreferenceResource{}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := test.prior
+
+			diags := r.apiPreferenceToModel(&client.Preference{Name: "listPageSize", Data: json.RawMessage(test.data)}, &data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			want := test.want
+			want.ID = types.StringValue("listPageSize")
+			want.Name = types.StringValue("listPageSize")
+			if !data.Value.Equal(want.Value) || !data.BoolValue.Equal(want.BoolValue) ||
+				!data.NumberValue.Equal(want.NumberValue) || !data.ValueJSON.Equal(want.ValueJSON) ||
+				!data.ID.Equal(want.ID) || !data.Name.Equal(want.Name) {
+				t.Errorf("apiPreferenceToModel() = %+v, want %+v", data, want)
+			}
+		})
+	}
+}
+
+func TestAccPreferenceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreferenceResourceConfig(`["1", "2"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_preference.test", "id", "frontpageAccounts"),
+					resource.TestCheckResourceAttr("firefly3_preference.test", "value_json", `["1", "2"]`),
+				),
+			},
+			{
+				Config: testAccPreferenceResourceConfig(`[ "1","2" ]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_preference.test", "value_json", `[ "1","2" ]`),
+				),
+			},
+			{
+				ResourceName:            "firefly3_preference.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value_json"},
+			},
+		},
+	})
+}
+
+func testAccPreferenceResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "firefly3_preference" "test" {
+  name       = "frontpageAccounts"
+  value_json = %q
+}
+`, value)
+}
@@ -33,8 +33,9 @@ type Firefly3Provider struct {
 
 // Firefly3ProviderModel describes the provider data model.
 type Firefly3ProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+	APIKey      types.String `tfsdk:"api_key"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
 }
 
 func (p *Firefly3Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,6 +55,11 @@ func (p *Firefly3Provider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"user_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user group (financial administration) to manage. Defaults to the current user group of the user. " +
+					"Can also be set via the `FIREFLY3_USER_GROUP_ID` environment variable, and overridden per resource.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -78,6 +84,11 @@ func (p *Firefly3Provider) Configure(ctx context.Context, req provider.Configure
 		apiKey = os.Getenv("FIREFLY3_API_KEY")
 	}
 
+	userGroupID := data.UserGroupID.ValueString()
+	if data.UserGroupID.IsNull() {
+		userGroupID = os.Getenv("FIREFLY3_USER_GROUP_ID")
+	}
+
 	if endpoint == "" {
 		resp.Diagnostics.AddError(
 			"Missing Endpoint",
@@ -97,15 +108,37 @@ func (p *Firefly3Provider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	apiClient := client.NewClient(endpoint, apiKey)
+	apiClient.UserGroupID = userGroupID
+
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
 }
 
 func (p *Firefly3Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccountResource,
+		NewAttachmentResource,
+		NewAvailableBudgetResource,
+		NewBillResource,
+		NewBudgetResource,
+		NewBudgetLimitResource,
 		NewCategoryResource,
+		NewConfigurationResource,
+		NewCurrencyResource,
+		NewCurrencyExchangeRateResource,
+		NewLinkTypeResource,
+		NewObjectGroupResource,
+		NewPiggyBankResource,
+		NewPreferenceResource,
+		NewRecurrenceResource,
 		NewRuleResource,
 		NewRuleGroupResource,
+		NewTagResource,
+		NewTransactionResource,
+		NewTransactionLinkResource,
+		NewUserResource,
+		NewUserGroupResource,
+		NewWebhookResource,
 	}
 }
 
@@ -114,7 +147,15 @@ func (p *Firefly3Provider) EphemeralResources(ctx context.Context) []func() ephe
 }
 
 func (p *Firefly3Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAboutDataSource,
+		NewAccountsDataSource,
+		NewCategoryDataSource,
+		NewCurrencyExchangeRateDataSource,
+		NewRuleGroupDataSource,
+		NewRuleGroupsDataSource,
+		NewRulesDataSource,
+	}
 }
 
 func (p *Firefly3Provider) Functions(ctx context.Context) []func() function.Function {
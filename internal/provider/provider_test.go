@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories instantiates the provider for acceptance
+// tests, which run against the Firefly III instance configured through the
+// FIREFLY3_ENDPOINT and FIREFLY3_API_KEY environment variables. Acceptance
+// tests only run when TF_ACC is set.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"firefly3": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func testAccPreCheck(t *testing.T) {
+	for _, name := range []string{"FIREFLY3_ENDPOINT", "FIREFLY3_API_KEY"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
@@ -0,0 +1,700 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &RecurrenceResource{}
+var _ resource.ResourceWithImportState = &RecurrenceResource{}
+var _ resource.ResourceWithModifyPlan = &RecurrenceResource{}
+
+func NewRecurrenceResource() resource.Resource {
+	return &RecurrenceResource{}
+}
+
+type RecurrenceResource struct {
+	client *client.Client
+}
+
+type RecurrenceResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	UserGroupID     types.String `tfsdk:"user_group_id"`
+	Type            types.String `tfsdk:"type"`
+	Title           types.String `tfsdk:"title"`
+	Description     types.String `tfsdk:"description"`
+	FirstDate       types.String `tfsdk:"first_date"`
+	RepeatUntil     types.String `tfsdk:"repeat_until"`
+	NrOfRepetitions types.Int32  `tfsdk:"nr_of_repetitions"`
+	ApplyRules      types.Bool   `tfsdk:"apply_rules"`
+	Active          types.Bool   `tfsdk:"active"`
+	Notes           types.String `tfsdk:"notes"`
+	Repetitions     types.List   `tfsdk:"repetitions"`
+	Transactions    types.List   `tfsdk:"transactions"`
+}
+
+type RecurrenceRepetitionModel struct {
+	ID      types.String `tfsdk:"id"`
+	Type    types.String `tfsdk:"type"`
+	Moment  types.String `tfsdk:"moment"`
+	Skip    types.Int32  `tfsdk:"skip"`
+	Weekend types.Int32  `tfsdk:"weekend"`
+}
+
+type RecurrenceTransactionModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Description         types.String `tfsdk:"description"`
+	Amount              types.String `tfsdk:"amount"`
+	CurrencyCode        types.String `tfsdk:"currency_code"`
+	ForeignAmount       types.String `tfsdk:"foreign_amount"`
+	ForeignCurrencyCode types.String `tfsdk:"foreign_currency_code"`
+	SourceID            types.String `tfsdk:"source_id"`
+	DestinationID       types.String `tfsdk:"destination_id"`
+	CategoryID          types.String `tfsdk:"category_id"`
+	BudgetID            types.String `tfsdk:"budget_id"`
+	PiggyBankID         types.String `tfsdk:"piggy_bank_id"`
+	Tags                types.Set    `tfsdk:"tags"`
+}
+
+var recurrenceRepetitionAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"type":    types.StringType,
+	"moment":  types.StringType,
+	"skip":    types.Int32Type,
+	"weekend": types.Int32Type,
+}
+
+var recurrenceTransactionAttrTypes = map[string]attr.Type{
+	"id":                    types.StringType,
+	"description":           types.StringType,
+	"amount":                types.StringType,
+	"currency_code":         types.StringType,
+	"foreign_amount":        types.StringType,
+	"foreign_currency_code": types.StringType,
+	"source_id":             types.StringType,
+	"destination_id":        types.StringType,
+	"category_id":           types.StringType,
+	"budget_id":             types.StringType,
+	"piggy_bank_id":         types.StringType,
+	"tags":                  types.SetType{ElemType: types.StringType},
+}
+
+func (r *RecurrenceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recurrence"
+}
+
+func (r *RecurrenceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III recurring transaction. Firefly III creates the transactions automatically according to the repetitions.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the recurring transaction.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of transaction that is created. Must be one of: `withdrawal`, `deposit` or `transfer`. Changing this forces a new recurring transaction.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("withdrawal", "deposit", "transfer"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Recurrence/StoreRequest.php
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title of the recurring transaction. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the recurring transaction.",
+			},
+			"first_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The date of the first transaction, formatted as `YYYY-MM-DD`.",
+			},
+			"repeat_until": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date after which no more transactions are created, formatted as `YYYY-MM-DD`. Conflicts with `nr_of_repetitions`.",
+			},
+			"nr_of_repetitions": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of transactions to create. Conflicts with `repeat_until`.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.ConflictsWith(path.MatchRoot("repeat_until")),
+				},
+			},
+			"apply_rules": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether rules are applied to the created transactions. Defaults to `true`.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the recurring transaction is active. Defaults to `true`.",
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the recurring transaction.",
+			},
+			"repetitions": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "List of repetitions that determine when transactions are created.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the repetition.",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of repetition. Must be one of: `daily`, `weekly`, `ndom`, `monthly` or `yearly`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("daily", "weekly", "ndom", "monthly", "yearly"),
+							},
+						},
+						"moment": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The moment of the repetition, depending on `type`: empty for `daily`, the day of the week (1-7) for `weekly`, " +
+								"the week and day of the week (e.g., `2,3` for the second Wednesday) for `ndom`, the day of the month for `monthly`, and a date formatted as `YYYY-MM-DD` for `yearly`.",
+						},
+						"skip": schema.Int32Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int32default.StaticInt32(0),
+							MarkdownDescription: "The number of periods to skip between transactions. Defaults to `0`.",
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+						"weekend": schema.Int32Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int32default.StaticInt32(1),
+							MarkdownDescription: "What to do when a transaction falls in the weekend: `1` to create it anyway, `2` to skip it, `3` to move it to the previous Friday or `4` to move it to the next Monday. Defaults to `1`.",
+							Validators: []validator.Int32{
+								int32validator.Between(1, 4),
+							},
+						},
+					},
+				},
+			},
+			"transactions": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "List of transactions that are created on every repetition. Multiple transactions create a split transaction.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the transaction.",
+						},
+						"description": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The description of the transaction.",
+						},
+						"amount": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The amount of the transaction, as a decimal string.",
+						},
+						"currency_code": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The currency code of the amount (e.g., `EUR`). Defaults to the primary currency of the user.",
+						},
+						"foreign_amount": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The amount in a foreign currency, as a decimal string. Requires `foreign_currency_code`.",
+						},
+						"foreign_currency_code": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The currency code of the foreign amount.",
+						},
+						"source_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ID of the source account.",
+						},
+						"destination_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ID of the destination account.",
+						},
+						"category_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the category of the transaction.",
+						},
+						"budget_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the budget of the transaction. Only applies to withdrawals.",
+						},
+						"piggy_bank_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the piggy bank the transaction is added to. Only applies to transfers.",
+						},
+						"tags": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Tags of the transaction.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RecurrenceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the IDs of the repetitions and transactions that are
+// updated in place. Elements are matched with the elements in state by their
+// attributes rather than their position, so that removing or reordering
+// elements does not update the wrong repetition or transaction in Firefly III.
+func (r *RecurrenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state RecurrenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Repetitions.IsUnknown() || plan.Transactions.IsUnknown() {
+		return
+	}
+
+	var planRepetitions, stateRepetitions []RecurrenceRepetitionModel
+	var planTransactions, stateTransactions []RecurrenceTransactionModel
+	resp.Diagnostics.Append(plan.Repetitions.ElementsAs(ctx, &planRepetitions, false)...)
+	resp.Diagnostics.Append(state.Repetitions.ElementsAs(ctx, &stateRepetitions, false)...)
+	resp.Diagnostics.Append(plan.Transactions.ElementsAs(ctx, &planTransactions, false)...)
+	resp.Diagnostics.Append(state.Transactions.ElementsAs(ctx, &stateTransactions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRecurrenceRepetitions(planRepetitions, stateRepetitions)
+	planRecurrenceTransactions(planTransactions, stateTransactions)
+
+	repetitions, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: recurrenceRepetitionAttrTypes}, planRepetitions)
+	resp.Diagnostics.Append(diags...)
+	transactions, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: recurrenceTransactionAttrTypes}, planTransactions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("repetitions"), repetitions)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("transactions"), transactions)...)
+}
+
+func (r *RecurrenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecurrenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	recurrence, diags := r.modelToAPIRecurrence(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdRecurrence, err := apiClient.CreateRecurrence(ctx, recurrence)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recurring transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiRecurrenceToModel(ctx, createdRecurrence, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a recurrence resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurrenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecurrenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	recurrence, err := apiClient.GetRecurrence(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Recurring transaction not found", fmt.Sprintf("Recurring transaction %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read recurring transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiRecurrenceToModel(ctx, recurrence, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurrenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecurrenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	recurrence, diags := r.modelToAPIRecurrence(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedRecurrence, err := apiClient.UpdateRecurrence(ctx, data.ID.ValueString(), recurrence)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recurring transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiRecurrenceToModel(ctx, updatedRecurrence, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurrenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecurrenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteRecurrence(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete recurring transaction, got error: %s", err))
+		return
+	}
+}
+
+func (r *RecurrenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *RecurrenceResource) modelToAPIRecurrence(ctx context.Context, data *RecurrenceResourceModel) (*client.Recurrence, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	recurrence := &client.Recurrence{
+		Type:            data.Type.ValueString(),
+		Title:           data.Title.ValueString(),
+		Description:     data.Description.ValueString(),
+		FirstDate:       data.FirstDate.ValueString(),
+		RepeatUntil:     data.RepeatUntil.ValueStringPointer(),
+		NrOfRepetitions: data.NrOfRepetitions.ValueInt32Pointer(),
+		ApplyRules:      data.ApplyRules.ValueBool(),
+		Active:          data.Active.ValueBool(),
+		Notes:           data.Notes.ValueString(),
+	}
+
+	var repetitionModels []RecurrenceRepetitionModel
+	diags.Append(data.Repetitions.ElementsAs(ctx, &repetitionModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	recurrence.Repetitions = make([]client.RecurrenceRepetition, len(repetitionModels))
+	for i, rep := range repetitionModels {
+		recurrence.Repetitions[i] = client.RecurrenceRepetition{
+			ID:      knownString(rep.ID),
+			Type:    rep.Type.ValueString(),
+			Moment:  rep.Moment.ValueString(),
+			Skip:    rep.Skip.ValueInt32(),
+			Weekend: rep.Weekend.ValueInt32(),
+		}
+	}
+
+	var transactionModels []RecurrenceTransactionModel
+	diags.Append(data.Transactions.ElementsAs(ctx, &transactionModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	recurrence.Transactions = make([]client.RecurrenceTransaction, len(transactionModels))
+	for i, t := range transactionModels {
+		transaction := client.RecurrenceTransaction{
+			ID:                  knownString(t.ID),
+			Description:         t.Description.ValueString(),
+			Amount:              t.Amount.ValueString(),
+			ForeignAmount:       t.ForeignAmount.ValueString(),
+			ForeignCurrencyCode: t.ForeignCurrencyCode.ValueString(),
+			SourceID:            t.SourceID.ValueString(),
+			DestinationID:       t.DestinationID.ValueString(),
+			CategoryID:          t.CategoryID.ValueString(),
+			BudgetID:            t.BudgetID.ValueString(),
+			PiggyBankID:         t.PiggyBankID.ValueString(),
+			Tags:                []string{},
+		}
+
+		if !t.CurrencyCode.IsNull() && !t.CurrencyCode.IsUnknown() {
+			transaction.CurrencyCode = t.CurrencyCode.ValueString()
+		}
+
+		if !t.Tags.IsNull() && !t.Tags.IsUnknown() {
+			diags.Append(t.Tags.ElementsAs(ctx, &transaction.Tags, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		recurrence.Transactions[i] = transaction
+	}
+
+	return recurrence, diags
+}
+
+func (r *RecurrenceResource) apiRecurrenceToModel(ctx context.Context, recurrence *client.Recurrence, data *RecurrenceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(recurrence.ID)
+	data.Type = types.StringValue(recurrence.Type)
+	data.Title = types.StringValue(recurrence.Title)
+	data.Description = optionalStringValue(recurrence.Description, data.Description)
+	data.FirstDate = dateValue(recurrence.FirstDate, data.FirstDate)
+	data.RepeatUntil = dateValue(stringValueOf(recurrence.RepeatUntil), data.RepeatUntil)
+	data.NrOfRepetitions = types.Int32Null()
+	if recurrence.NrOfRepetitions != nil && *recurrence.NrOfRepetitions > 0 {
+		data.NrOfRepetitions = types.Int32Value(*recurrence.NrOfRepetitions)
+	}
+	data.ApplyRules = types.BoolValue(recurrence.ApplyRules)
+	data.Active = types.BoolValue(recurrence.Active)
+	data.Notes = optionalStringValue(recurrence.Notes, data.Notes)
+
+	// The prior values are used to keep unset optional attributes null and
+	// configured amounts in their original notation.
+	var priorRepetitions []RecurrenceRepetitionModel
+	if !data.Repetitions.IsNull() && !data.Repetitions.IsUnknown() {
+		diags.Append(data.Repetitions.ElementsAs(ctx, &priorRepetitions, false)...)
+	}
+
+	var priorTransactions []RecurrenceTransactionModel
+	if !data.Transactions.IsNull() && !data.Transactions.IsUnknown() {
+		diags.Append(data.Transactions.ElementsAs(ctx, &priorTransactions, false)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	repetitionValues := make([]attr.Value, len(recurrence.Repetitions))
+	for i, rep := range recurrence.Repetitions {
+		var prior RecurrenceRepetitionModel
+		if j := priorIndex(priorRepetitions, i, func(p RecurrenceRepetitionModel) bool { return p.ID.ValueString() == rep.ID }); j >= 0 {
+			prior = priorRepetitions[j]
+		}
+
+		repetitionValues[i], _ = types.ObjectValue(recurrenceRepetitionAttrTypes, map[string]attr.Value{
+			"id":      types.StringValue(rep.ID),
+			"type":    types.StringValue(rep.Type),
+			"moment":  optionalStringValue(rep.Moment, prior.Moment),
+			"skip":    types.Int32Value(rep.Skip),
+			"weekend": types.Int32Value(rep.Weekend),
+		})
+	}
+	data.Repetitions, _ = types.ListValue(types.ObjectType{AttrTypes: recurrenceRepetitionAttrTypes}, repetitionValues)
+
+	transactionValues := make([]attr.Value, len(recurrence.Transactions))
+	for i, t := range recurrence.Transactions {
+		var prior RecurrenceTransactionModel
+		if j := priorIndex(priorTransactions, i, func(p RecurrenceTransactionModel) bool { return p.ID.ValueString() == t.ID }); j >= 0 {
+			prior = priorTransactions[j]
+		}
+
+		tags := types.SetNull(types.StringType)
+		if len(t.Tags) > 0 || (!prior.Tags.IsNull() && !prior.Tags.IsUnknown()) {
+			var d diag.Diagnostics
+			tags, d = types.SetValueFrom(ctx, types.StringType, append([]string{}, t.Tags...))
+			diags.Append(d...)
+		}
+
+		transactionValues[i], _ = types.ObjectValue(recurrenceTransactionAttrTypes, map[string]attr.Value{
+			"id":                    types.StringValue(t.ID),
+			"description":           types.StringValue(t.Description),
+			"amount":                decimalValue(t.Amount, prior.Amount),
+			"currency_code":         types.StringValue(t.CurrencyCode),
+			"foreign_amount":        decimalValue(t.ForeignAmount, prior.ForeignAmount),
+			"foreign_currency_code": optionalStringValue(t.ForeignCurrencyCode, prior.ForeignCurrencyCode),
+			"source_id":             types.StringValue(t.SourceID),
+			"destination_id":        types.StringValue(t.DestinationID),
+			"category_id":           optionalStringValue(t.CategoryID, prior.CategoryID),
+			"budget_id":             optionalStringValue(t.BudgetID, prior.BudgetID),
+			"piggy_bank_id":         optionalStringValue(t.PiggyBankID, prior.PiggyBankID),
+			"tags":                  tags,
+		})
+	}
+	data.Transactions, _ = types.ListValue(types.ObjectType{AttrTypes: recurrenceTransactionAttrTypes}, transactionValues)
+
+	return diags
+}
+
+// priorIndex returns the index of the prior element with the same ID as an
+// element returned by the API, falling back to its position i when no prior
+// element has a known ID that matches. It returns -1 when there is none.
+func priorIndex[T any](prior []T, i int, sameID func(T) bool) int {
+	if j := slices.IndexFunc(prior, sameID); j >= 0 {
+		return j
+	}
+	if i < len(prior) {
+		return i
+	}
+
+	return -1
+}
+
+// matchElements returns for every planned element the index of the element in
+// prior it updates, or -1 for new elements. Planned elements are first
+// matched with an equal element and then with an element for which sameKey
+// reports true. Every prior element is matched at most once.
+func matchElements[T any](planned, prior []T, equal, sameKey func(planned, prior T) bool) []int {
+	matches := make([]int, len(planned))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	used := make([]bool, len(prior))
+	for _, match := range []func(planned, prior T) bool{equal, sameKey} {
+		for i := range planned {
+			if matches[i] >= 0 {
+				continue
+			}
+			for j := range prior {
+				if !used[j] && match(planned[i], prior[j]) {
+					matches[i], used[j] = j, true
+					break
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+// planRecurrenceRepetitions plans the IDs of repetitions, which are matched
+// with the repetitions in state by their type and moment.
+func planRecurrenceRepetitions(planned, prior []RecurrenceRepetitionModel) {
+	matches := matchElements(planned, prior,
+		func(p, s RecurrenceRepetitionModel) bool {
+			return p.Type.Equal(s.Type) && p.Moment.Equal(s.Moment) && p.Skip.Equal(s.Skip) && p.Weekend.Equal(s.Weekend)
+		},
+		func(p, s RecurrenceRepetitionModel) bool {
+			return p.Type.Equal(s.Type) && p.Moment.Equal(s.Moment)
+		},
+	)
+
+	for i, j := range matches {
+		planned[i].ID = types.StringUnknown()
+		if j >= 0 {
+			planned[i].ID = prior[j].ID
+		}
+	}
+}
+
+// planRecurrenceTransactions plans the IDs and unset currency codes of
+// transactions, which are matched with the transactions in state by their
+// source and destination accounts.
+func planRecurrenceTransactions(planned, prior []RecurrenceTransactionModel) {
+	matches := matchElements(planned, prior,
+		func(p, s RecurrenceTransactionModel) bool {
+			return p.Description.Equal(s.Description) && p.Amount.Equal(s.Amount) &&
+				(p.CurrencyCode.IsUnknown() || p.CurrencyCode.Equal(s.CurrencyCode)) &&
+				p.ForeignAmount.Equal(s.ForeignAmount) && p.ForeignCurrencyCode.Equal(s.ForeignCurrencyCode) &&
+				p.SourceID.Equal(s.SourceID) && p.DestinationID.Equal(s.DestinationID) &&
+				p.CategoryID.Equal(s.CategoryID) && p.BudgetID.Equal(s.BudgetID) && p.PiggyBankID.Equal(s.PiggyBankID) &&
+				p.Tags.Equal(s.Tags)
+		},
+		func(p, s RecurrenceTransactionModel) bool {
+			return p.SourceID.Equal(s.SourceID) && p.DestinationID.Equal(s.DestinationID)
+		},
+	)
+
+	for i, j := range matches {
+		planned[i].ID = types.StringUnknown()
+		if j >= 0 {
+			planned[i].ID = prior[j].ID
+			if planned[i].CurrencyCode.IsUnknown() {
+				planned[i].CurrencyCode = prior[j].CurrencyCode
+			}
+		}
+	}
+}
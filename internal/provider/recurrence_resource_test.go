@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPlanRecurrenceRepetitions(t *testing.T) {
+	repetition := func(id, moment string, skip int32) RecurrenceRepetitionModel {
+		return RecurrenceRepetitionModel{
+			ID:      types.StringValue(id),
+			Type:    types.StringValue("monthly"),
+			Moment:  types.StringValue(moment),
+			Skip:    types.Int32Value(skip),
+			Weekend: types.Int32Value(1),
+		}
+	}
+	state := []RecurrenceRepetitionModel{repetition("1", "1", 0), repetition("2", "15", 0)}
+
+	tests := map[string]struct {
+		planned []RecurrenceRepetitionModel
+		wantIDs []types.String
+	}{
+		"unchanged": {
+			planned: []RecurrenceRepetitionModel{repetition("1", "1", 0), repetition("2", "15", 0)},
+			wantIDs: []types.String{types.StringValue("1"), types.StringValue("2")},
+		},
+		"reordered": {
+			planned: []RecurrenceRepetitionModel{repetition("1", "15", 0), repetition("2", "1", 0)},
+			wantIDs: []types.String{types.StringValue("2"), types.StringValue("1")},
+		},
+		"first removed": {
+			planned: []RecurrenceRepetitionModel{repetition("1", "15", 0)},
+			wantIDs: []types.String{types.StringValue("2")},
+		},
+		"changed skip": {
+			planned: []RecurrenceRepetitionModel{repetition("1", "1", 0), repetition("2", "15", 1)},
+			wantIDs: []types.String{types.StringValue("1"), types.StringValue("2")},
+		},
+		"new moment": {
+			planned: []RecurrenceRepetitionModel{repetition("1", "1", 0), repetition("2", "28", 0)},
+			wantIDs: []types.String{types.StringValue("1"), types.StringUnknown()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planRecurrenceRepetitions(test.planned, state)
+			for i, want := range test.wantIDs {
+				if got := test.planned[i].ID; !got.Equal(want) {
+					t.Errorf("repetitions[%d].id = %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanRecurrenceTransactions(t *testing.T) {
+	transaction := func(id, amount, sourceID string) RecurrenceTransactionModel {
+		return RecurrenceTransactionModel{
+			ID:            types.StringValue(id),
+			Description:   types.StringValue("Rent"),
+			Amount:        types.StringValue(amount),
+			CurrencyCode:  types.StringValue("EUR"),
+			SourceID:      types.StringValue(sourceID),
+			DestinationID: types.StringValue("10"),
+			Tags:          types.SetNull(types.StringType),
+		}
+	}
+	state := []RecurrenceTransactionModel{transaction("1", "600", "3"), transaction("2", "600", "4")}
+
+	// The framework plans an unset currency code as unknown whenever the
+	// list changes.
+	changed := transaction("", "650", "4")
+	changed.ID, changed.CurrencyCode = types.StringUnknown(), types.StringUnknown()
+
+	planned := []RecurrenceTransactionModel{changed, transaction("", "600", "5")}
+	planned[1].ID = types.StringUnknown()
+
+	planRecurrenceTransactions(planned, state)
+
+	if !planned[0].ID.Equal(types.StringValue("2")) || !planned[0].CurrencyCode.Equal(types.StringValue("EUR")) {
+		t.Errorf("transactions[0] = (%s, %s), want the ID and currency code of the transaction from account 4", planned[0].ID, planned[0].CurrencyCode)
+	}
+	if !planned[1].ID.IsUnknown() {
+		t.Errorf("transactions[1].id = %s, want unknown for a new transaction", planned[1].ID)
+	}
+}
+
+func TestAccRecurrenceResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecurrenceResourceConfig(name, "1250", `repeat_until = "2031-12-31"`, `"1", "15"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_recurrence.test", "id"),
+					resource.TestCheckResourceAttrSet("firefly3_recurrence.test", "repetitions.0.id"),
+					resource.TestCheckResourceAttrSet("firefly3_recurrence.test", "transactions.0.id"),
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "transactions.0.amount", "1250"),
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "repeat_until", "2031-12-31"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_recurrence.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecurrenceResourceConfig(name, "1300.00", `nr_of_repetitions = 12`, `"15"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "transactions.0.amount", "1300.00"),
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "repetitions.#", "1"),
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "repetitions.0.moment", "15"),
+					resource.TestCheckResourceAttr("firefly3_recurrence.test", "nr_of_repetitions", "12"),
+					resource.TestCheckNoResourceAttr("firefly3_recurrence.test", "repeat_until"),
+				),
+			},
+			{
+				Config: testAccRecurrenceResourceConfig(name, "1300.00", "", `"15"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("firefly3_recurrence.test", "nr_of_repetitions"),
+					resource.TestCheckNoResourceAttr("firefly3_recurrence.test", "repeat_until"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRecurrenceResourceConfig returns a monthly recurring transaction on
+// the given days of the month, with end as the extra attribute that ends it.
+func testAccRecurrenceResourceConfig(name, amount, end, moments string) string {
+	return testAccAssetAccountConfig(name) + fmt.Sprintf(`
+resource "firefly3_recurrence" "test" {
+  type       = "withdrawal"
+  title      = %[1]q
+  first_date = "2030-01-01"
+  %[3]s
+
+  repetitions = [
+    for moment in [%[4]s] : {
+      type   = "monthly"
+      moment = moment
+    }
+  ]
+
+  transactions = [
+    {
+      description    = "%[1]s rent"
+      amount         = %[2]q
+      source_id      = firefly3_account.asset.id
+      destination_id = firefly3_account.expense.id
+      tags           = ["%[1]s-b", "%[1]s-a"]
+    },
+  ]
+}
+`, name, amount, end, moments)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &RuleGroupDataSource{}
+
+func NewRuleGroupDataSource() datasource.DataSource {
+	return &RuleGroupDataSource{}
+}
+
+type RuleGroupDataSource struct {
+	client *client.Client
+}
+
+type RuleGroupDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Order       types.Int32  `tfsdk:"order"`
+	Active      types.Bool   `tfsdk:"active"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+// ruleSummaryAttrTypes are the attribute types of the rules of a rule group.
+var ruleSummaryAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"title": types.StringType,
+}
+
+func (d *RuleGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_group"
+}
+
+func (d *RuleGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Firefly III rule group by ID or by title, including the rules it contains.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the rule group. Exactly one of `id` or `title` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("title")),
+				},
+			},
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"title": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The exact title of the rule group.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A description of what the rule group is for.",
+			},
+			"order": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "The order of the rule group. Rule groups with a lower order are executed first.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether or not the rule group is active.",
+			},
+			"rules": ruleSummariesAttribute(),
+		},
+	}
+}
+
+func (d *RuleGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RuleGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuleGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	var ruleGroup *client.RuleGroup
+	if data.ID.IsNull() {
+		ruleGroups, err := apiClient.ListRuleGroups(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rule groups, got error: %s", err))
+			return
+		}
+
+		for i := range ruleGroups {
+			if ruleGroups[i].Title == data.Title.ValueString() {
+				ruleGroup = &ruleGroups[i]
+				break
+			}
+		}
+
+		if ruleGroup == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title"),
+				"Rule Group Not Found",
+				fmt.Sprintf("No rule group with title %q exists.", data.Title.ValueString()),
+			)
+			return
+		}
+	} else {
+		var err error
+		ruleGroup, err = apiClient.GetRuleGroup(ctx, data.ID.ValueString())
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.Diagnostics.AddAttributeError(path.Root("id"), "Rule Group Not Found", fmt.Sprintf("No rule group with ID %s exists.", data.ID.ValueString()))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rule group, got error: %s", err))
+			return
+		}
+	}
+
+	rules, err := apiClient.ListRuleGroupRules(ctx, ruleGroup.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rules of rule group, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(ruleGroup.ID)
+	data.Title = types.StringValue(ruleGroup.Title)
+	data.Description = nullableStringValue(ruleGroup.Description)
+	data.Order = types.Int32Value(ruleGroup.Order)
+	data.Active = types.BoolValue(ruleGroup.Active)
+	data.Rules = ruleSummariesValue(rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ruleSummariesAttribute is the rules attribute of the rule group data
+// sources, which lists the rules of a group by ID and title.
+func ruleSummariesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The rules in the rule group, in the order in which they are executed.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The unique identifier of the rule.",
+				},
+				"title": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The title of the rule.",
+				},
+			},
+		},
+	}
+}
+
+// ruleSummariesValue converts API rules into a list of rule objects with an
+// ID and title.
+func ruleSummariesValue(rules []client.Rule) types.List {
+	ruleValues := make([]attr.Value, len(rules))
+	for i, rule := range rules {
+		ruleValues[i], _ = types.ObjectValue(ruleSummaryAttrTypes, map[string]attr.Value{
+			"id":    types.StringValue(rule.ID),
+			"title": types.StringValue(rule.Title),
+		})
+	}
+
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: ruleSummaryAttrTypes}, ruleValues)
+	return list
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRuleGroupDataSources(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig(name) + fmt.Sprintf(`
+data "firefly3_rule_group" "test" {
+  title = firefly3_rule_group.test.title
+
+  depends_on = [firefly3_rule.test]
+}
+
+data "firefly3_rule_groups" "test" {
+  title_regex = "^%s$"
+
+  depends_on = [firefly3_rule.test]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firefly3_rule_group.test", "id", "firefly3_rule_group.test", "id"),
+					resource.TestCheckResourceAttr("data.firefly3_rule_group.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firefly3_rule_group.test", "rules.0.id", "firefly3_rule.test", "id"),
+					resource.TestCheckResourceAttr("data.firefly3_rule_groups.test", "rule_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firefly3_rule_groups.test", "rule_groups.0.id", "firefly3_rule_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "firefly3_rule_group" "test" {
+  title = %[1]q
+}
+
+resource "firefly3_rule" "test" {
+  rule_group_id = firefly3_rule_group.test.id
+  title         = "%[1]s supermarket"
+  trigger       = "store-journal"
+
+  triggers = [
+    {
+      type  = "description_contains"
+      value = "SUPERMARKET"
+    }
+  ]
+
+  actions = [
+    {
+      type  = "set_category"
+      value = "Groceries"
+    }
+  ]
+}
+`, name)
+}
@@ -33,6 +33,7 @@ type RuleGroupResource struct {
 
 type RuleGroupResourceModel struct {
 	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
 	Title       types.String `tfsdk:"title"`
 	Description types.String `tfsdk:"description"`
 	Order       types.Int32  `tfsdk:"order"`
@@ -55,6 +56,7 @@ func (r *RuleGroupResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"user_group_id": userGroupIDAttribute(),
 			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/RuleGroup/UpdateRequest.php
 			"title": schema.StringAttribute{
 				Required:            true,
@@ -107,9 +109,11 @@ func (r *RuleGroupResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	ruleGroup := r.modelToAPIRuleGroup(&data)
 
-	createdRuleGroup, err := r.client.CreateRuleGroup(ctx, ruleGroup)
+	createdRuleGroup, err := apiClient.CreateRuleGroup(ctx, ruleGroup)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create rule group, got error: %s", err))
 		return
@@ -130,7 +134,9 @@ func (r *RuleGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	ruleGroup, err := r.client.GetRuleGroup(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	ruleGroup, err := apiClient.GetRuleGroup(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rule group, got error: %s", err))
 		return
@@ -149,9 +155,11 @@ func (r *RuleGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	ruleGroup := r.modelToAPIRuleGroup(&data)
 
-	updatedRuleGroup, err := r.client.UpdateRuleGroup(ctx, data.ID.ValueString(), ruleGroup)
+	updatedRuleGroup, err := apiClient.UpdateRuleGroup(ctx, data.ID.ValueString(), ruleGroup)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update rule group, got error: %s", err))
 		return
@@ -170,7 +178,9 @@ func (r *RuleGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteRuleGroup(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteRuleGroup(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rule group, got error: %s", err))
 		return
@@ -178,6 +188,7 @@ func (r *RuleGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *RuleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &RuleGroupsDataSource{}
+
+func NewRuleGroupsDataSource() datasource.DataSource {
+	return &RuleGroupsDataSource{}
+}
+
+type RuleGroupsDataSource struct {
+	client *client.Client
+}
+
+type RuleGroupsDataSourceModel struct {
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	TitleRegex  types.String `tfsdk:"title_regex"`
+	Active      types.Bool   `tfsdk:"active"`
+	RuleGroups  types.List   `tfsdk:"rule_groups"`
+}
+
+// ruleGroupAttrTypes are the attribute types of the objects in rule_groups.
+var ruleGroupAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"title":       types.StringType,
+	"description": types.StringType,
+	"order":       types.Int32Type,
+	"active":      types.BoolType,
+	"rules":       types.ListType{ElemType: types.ObjectType{AttrTypes: ruleSummaryAttrTypes}},
+}
+
+func (d *RuleGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_groups"
+}
+
+func (d *RuleGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Firefly III rule groups and the rules they contain, optionally filtered by title and status.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"title_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list rule groups whose title matches this [regular expression](https://pkg.go.dev/regexp/syntax).",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list active (`true`) or inactive (`false`) rule groups. Lists both when not set.",
+			},
+			"rule_groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The rule groups that match the filters, in the order in which they are executed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the rule group.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the rule group.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of what the rule group is for.",
+						},
+						"order": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: "The order of the rule group.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether or not the rule group is active.",
+						},
+						"rules": ruleSummariesAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RuleGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RuleGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuleGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !data.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(data.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("title_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	ruleGroups, err := apiClient.ListRuleGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rule groups, got error: %s", err))
+		return
+	}
+
+	var ruleGroupValues []attr.Value
+	for _, ruleGroup := range ruleGroups {
+		if !data.Active.IsNull() && ruleGroup.Active != data.Active.ValueBool() {
+			continue
+		}
+		if titleRegex != nil && !titleRegex.MatchString(ruleGroup.Title) {
+			continue
+		}
+
+		rules, err := apiClient.ListRuleGroupRules(ctx, ruleGroup.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rules of rule group %s, got error: %s", ruleGroup.ID, err))
+			return
+		}
+
+		ruleGroupValue, _ := types.ObjectValue(ruleGroupAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(ruleGroup.ID),
+			"title":       types.StringValue(ruleGroup.Title),
+			"description": nullableStringValue(ruleGroup.Description),
+			"order":       types.Int32Value(ruleGroup.Order),
+			"active":      types.BoolValue(ruleGroup.Active),
+			"rules":       ruleSummariesValue(rules),
+		})
+		ruleGroupValues = append(ruleGroupValues, ruleGroupValue)
+	}
+
+	data.RuleGroups, _ = types.ListValue(types.ObjectType{AttrTypes: ruleGroupAttrTypes}, ruleGroupValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
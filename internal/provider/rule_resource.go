@@ -22,6 +22,15 @@ import (
 // Interface guards
 var _ resource.Resource = &RuleResource{}
 var _ resource.ResourceWithImportState = &RuleResource{}
+var _ resource.ResourceWithModifyPlan = &RuleResource{}
+
+// ruleTriggerMinVersion is the first Firefly III version that is assumed to
+// store rule triggers other than store-journal through the API. Older
+// versions store every rule with store-journal. The release that fixed this
+// has not been confirmed against the Firefly III changelog; if a server from
+// this version on still stores the wrong trigger, checkRuleTrigger reports it
+// right after the write.
+const ruleTriggerMinVersion = "6.1.0"
 
 func NewRuleResource() resource.Resource {
 	return &RuleResource{}
@@ -33,6 +42,7 @@ type RuleResource struct {
 
 type RuleResourceModel struct {
 	ID             types.String `tfsdk:"id"`
+	UserGroupID    types.String `tfsdk:"user_group_id"`
 	Title          types.String `tfsdk:"title"`
 	Description    types.String `tfsdk:"description"`
 	RuleGroupID    types.String `tfsdk:"rule_group_id"`
@@ -44,6 +54,23 @@ type RuleResourceModel struct {
 	Actions        types.List   `tfsdk:"actions"`
 }
 
+// ruleTriggerAttrTypes are the attribute types of the objects in triggers.
+var ruleTriggerAttrTypes = map[string]attr.Type{
+	"type":            types.StringType,
+	"value":           types.StringType,
+	"active":          types.BoolType,
+	"prohibited":      types.BoolType,
+	"stop_processing": types.BoolType,
+}
+
+// ruleActionAttrTypes are the attribute types of the objects in actions.
+var ruleActionAttrTypes = map[string]attr.Type{
+	"type":            types.StringType,
+	"value":           types.StringType,
+	"active":          types.BoolType,
+	"stop_processing": types.BoolType,
+}
+
 type RuleTriggerModel struct {
 	Type           types.String `tfsdk:"type"`
 	Value          types.String `tfsdk:"value"`
@@ -75,6 +102,7 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"user_group_id": userGroupIDAttribute(),
 			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Rule/UpdateRequest.php#L137
 			"title": schema.StringAttribute{
 				Required:            true,
@@ -92,11 +120,11 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "ID of the rule group under which the rule is stored.",
 			},
 			"trigger": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "When the rule should fire. Must be one of: `store-journal`, `update-journal`, or `manual-activation`.",
+				Required: true,
+				MarkdownDescription: "When the rule should fire. Must be one of: `store-journal`, `update-journal`, or `manual-activation`. " +
+					"Firefly III versions before " + ruleTriggerMinVersion + " only accept `store-journal` through the API, so other triggers are rejected during planning on those versions.",
 				Validators: []validator.String{
-					stringvalidator.OneOf("store-journal"),
-					// stringvalidator.OneOf("store-journal", "update-journal", "manual-activation"), only store-journal due to a bug in the API.
+					stringvalidator.OneOf("store-journal", "update-journal", "manual-activation"),
 				},
 			},
 			"active": schema.BoolAttribute{
@@ -274,13 +302,15 @@ func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	rule, diags := r.modelToAPIRule(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createdRule, err := r.client.CreateRule(ctx, rule)
+	createdRule, err := apiClient.CreateRule(ctx, rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create rule, got error: %s", err))
 		return
@@ -291,6 +321,7 @@ func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Trace(ctx, "created a rule resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(checkRuleTrigger(ctx, apiClient, rule.Trigger, createdRule.Trigger)...)
 }
 
 func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -301,7 +332,9 @@ func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	rule, err := r.client.GetRule(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	rule, err := apiClient.GetRule(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rule, got error: %s", err))
 		return
@@ -320,13 +353,15 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
 	rule, diags := r.modelToAPIRule(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updatedRule, err := r.client.UpdateRule(ctx, data.ID.ValueString(), rule)
+	updatedRule, err := apiClient.UpdateRule(ctx, data.ID.ValueString(), rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update rule, got error: %s, rule %+v", err, rule))
 		return
@@ -335,6 +370,7 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	r.apiRuleToModel(updatedRule, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(checkRuleTrigger(ctx, apiClient, rule.Trigger, updatedRule.Trigger)...)
 }
 
 func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -345,7 +381,9 @@ func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteRule(ctx, data.ID.ValueString())
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteRule(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rule, got error: %s", err))
 		return
@@ -353,9 +391,66 @@ func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *RuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ModifyPlan rejects triggers other than store-journal on Firefly III versions
+// that cannot store them, before any rule is written.
+func (r *RuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var trigger types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("trigger"), &trigger)...)
+	if resp.Diagnostics.HasError() || trigger.IsUnknown() || trigger.ValueString() == "store-journal" {
+		return
+	}
+
+	// The version is only requested for triggers that need it and is cached
+	// by the client. Without it, checkRuleTrigger still reports the problem
+	// after the write.
+	version, err := r.client.ServerVersion(ctx)
+	if err != nil {
+		return
+	}
+
+	if atLeast, ok := versionAtLeast(version, ruleTriggerMinVersion); ok && !atLeast {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger"),
+			"Unsupported Rule Trigger",
+			fmt.Sprintf("Firefly III %s stores every rule created through the API with trigger \"store-journal\". Use \"store-journal\", or upgrade to Firefly III %s or later to use %q.",
+				version, ruleTriggerMinVersion, trigger.ValueString()),
+		)
+	}
+}
+
+// checkRuleTrigger returns an error when Firefly III stored another trigger
+// than was requested on a server whose version could not be checked during
+// planning. A created rule is kept in state and tainted, so the next apply
+// replaces it; after an update, the state holds the stored trigger.
+func checkRuleTrigger(ctx context.Context, apiClient *client.Client, requested, stored string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if requested == stored {
+		return diags
+	}
+
+	version, err := apiClient.ServerVersion(ctx)
+	if err != nil {
+		version = "(unknown version)"
+	}
+
+	diags.AddAttributeError(
+		path.Root("trigger"),
+		"Unsupported Rule Trigger",
+		fmt.Sprintf("Firefly III %s stored the rule with trigger %q instead of %q. This version does not support the trigger through the API; use \"store-journal\" instead.", version, stored, requested),
+	)
+	return diags
+}
+
 func (r *RuleResource) modelToAPIRule(ctx context.Context, data *RuleResourceModel) (*client.Rule, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -415,17 +510,15 @@ func (r *RuleResource) apiRuleToModel(rule *client.Rule, data *RuleResourceModel
 	data.Strict = types.BoolValue(rule.Strict)
 	data.StopProcessing = types.BoolValue(rule.StopProcessing)
 
-	triggerAttrTypes := map[string]attr.Type{
-		"type":            types.StringType,
-		"value":           types.StringType,
-		"active":          types.BoolType,
-		"prohibited":      types.BoolType,
-		"stop_processing": types.BoolType,
-	}
+	data.Triggers = ruleTriggersValue(rule.Triggers)
+	data.Actions = ruleActionsValue(rule.Actions)
+}
 
-	triggerValues := make([]attr.Value, len(rule.Triggers))
-	for i, t := range rule.Triggers {
-		triggerValues[i], _ = types.ObjectValue(triggerAttrTypes, map[string]attr.Value{
+// ruleTriggersValue converts API triggers into the triggers list of a rule.
+func ruleTriggersValue(triggers []client.RuleTrigger) types.List {
+	triggerValues := make([]attr.Value, len(triggers))
+	for i, t := range triggers {
+		triggerValues[i], _ = types.ObjectValue(ruleTriggerAttrTypes, map[string]attr.Value{
 			"type":            types.StringValue(t.Type),
 			"value":           types.StringValue(t.Value),
 			"active":          types.BoolValue(t.Active),
@@ -433,23 +526,23 @@ func (r *RuleResource) apiRuleToModel(rule *client.Rule, data *RuleResourceModel
 			"stop_processing": types.BoolValue(t.StopProcessing),
 		})
 	}
-	data.Triggers, _ = types.ListValue(types.ObjectType{AttrTypes: triggerAttrTypes}, triggerValues)
 
-	actionAttrTypes := map[string]attr.Type{
-		"type":            types.StringType,
-		"value":           types.StringType,
-		"active":          types.BoolType,
-		"stop_processing": types.BoolType,
-	}
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: ruleTriggerAttrTypes}, triggerValues)
+	return list
+}
 
-	actionValues := make([]attr.Value, len(rule.Actions))
-	for i, a := range rule.Actions {
-		actionValues[i], _ = types.ObjectValue(actionAttrTypes, map[string]attr.Value{
+// ruleActionsValue converts API actions into the actions list of a rule.
+func ruleActionsValue(actions []client.RuleAction) types.List {
+	actionValues := make([]attr.Value, len(actions))
+	for i, a := range actions {
+		actionValues[i], _ = types.ObjectValue(ruleActionAttrTypes, map[string]attr.Value{
 			"type":            types.StringValue(a.Type),
 			"value":           types.StringValue(a.Value),
 			"active":          types.BoolValue(a.Active),
 			"stop_processing": types.BoolValue(a.StopProcessing),
 		})
 	}
-	data.Actions, _ = types.ListValue(types.ObjectType{AttrTypes: actionAttrTypes}, actionValues)
+
+	list, _ := types.ListValue(types.ObjectType{AttrTypes: ruleActionAttrTypes}, actionValues)
+	return list
 }
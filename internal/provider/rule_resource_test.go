@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+func TestRuleResourceModifyPlan(t *testing.T) {
+	tests := map[string]struct {
+		version      string
+		status       int
+		trigger      string
+		wantRequests int
+		wantErr      bool
+	}{
+		"store-journal":         {version: "6.0.30", status: http.StatusOK, trigger: "store-journal"},
+		"supported version":     {version: "6.1.24", status: http.StatusOK, trigger: "update-journal", wantRequests: 1},
+		"unsupported version":   {version: "6.0.30", status: http.StatusOK, trigger: "update-journal", wantRequests: 1, wantErr: true},
+		"development version":   {version: "develop/2026-01-31", status: http.StatusOK, trigger: "manual-activation", wantRequests: 1},
+		"version not available": {status: http.StatusInternalServerError, trigger: "manual-activation", wantRequests: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(test.status)
+				fmt.Fprintf(w, `{"data":{"version":%q,"api_version":%[1]q,"php_version":"8.3.0","os":"Linux","driver":"mysql"}}`, test.version)
+			}))
+			defer server.Close()
+
+			rule := &RuleResourceModel{
+				Title:          types.StringValue("Supermarket"),
+				RuleGroupID:    types.StringValue("1"),
+				Trigger:        types.StringValue(test.trigger),
+				Active:         types.BoolValue(true),
+				Strict:         types.BoolValue(true),
+				StopProcessing: types.BoolValue(false),
+				Triggers:       types.ListNull(types.ObjectType{AttrTypes: ruleTriggerAttrTypes}),
+				Actions:        types.ListNull(types.ObjectType{AttrTypes: ruleActionAttrTypes}),
+			}
+
+			r := &RuleResource{client: client.NewClient(server.URL, "secret")}
+			_, diags := testModifyPlan(t, r, rule, nil, rule)
+			if diags.HasError() != test.wantErr {
+				t.Errorf("ModifyPlan() diagnostics = %v, want error %t", diags, test.wantErr)
+			}
+			if requests != test.wantRequests {
+				t.Errorf("ModifyPlan() made %d requests, want %d", requests, test.wantRequests)
+			}
+		})
+	}
+}
+
+func TestCheckRuleTrigger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/about" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"version":"6.0.30","api_version":"6.0.30","php_version":"8.3.0","os":"Linux","driver":"mysql"}}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(server.URL, "secret")
+
+	diags := checkRuleTrigger(context.Background(), apiClient, "store-journal", "store-journal")
+	if diags.HasError() {
+		t.Errorf("checkRuleTrigger() with matching triggers returned diagnostics: %v", diags)
+	}
+
+	diags = checkRuleTrigger(context.Background(), apiClient, "update-journal", "store-journal")
+	if !diags.HasError() {
+		t.Fatal("checkRuleTrigger() with another stored trigger returned no error")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "6.0.30") || !strings.Contains(detail, `"update-journal"`) {
+		t.Errorf("checkRuleTrigger() detail = %q, want the server version and the requested trigger", detail)
+	}
+}
+
+func TestCheckRuleTriggerUnknownVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	diags := checkRuleTrigger(context.Background(), client.NewClient(server.URL, "secret"), "manual-activation", "store-journal")
+	if !diags.HasError() {
+		t.Fatal("checkRuleTrigger() with another stored trigger returned no error")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "(unknown version)") {
+		t.Errorf("checkRuleTrigger() detail = %q, want an unknown version", detail)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ datasource.DataSource = &RulesDataSource{}
+
+func NewRulesDataSource() datasource.DataSource {
+	return &RulesDataSource{}
+}
+
+type RulesDataSource struct {
+	client *client.Client
+}
+
+type RulesDataSourceModel struct {
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	RuleGroupID types.String `tfsdk:"rule_group_id"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+// ruleAttrTypes are the attribute types of the objects in rules, which match
+// the attributes of the firefly3_rule resource.
+var ruleAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"title":           types.StringType,
+	"description":     types.StringType,
+	"rule_group_id":   types.StringType,
+	"trigger":         types.StringType,
+	"active":          types.BoolType,
+	"strict":          types.BoolType,
+	"stop_processing": types.BoolType,
+	"triggers":        types.ListType{ElemType: types.ObjectType{AttrTypes: ruleTriggerAttrTypes}},
+	"actions":         types.ListType{ElemType: types.ObjectType{AttrTypes: ruleActionAttrTypes}},
+}
+
+func (d *RulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules"
+}
+
+func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Firefly III rules with their triggers and actions, in the same shape as the `firefly3_rule` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_group_id": userGroupIDDataSourceAttribute(),
+			"rule_group_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the rules in this rule group. Lists the rules of all rule groups when not set.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the rule.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of what the rule does.",
+						},
+						"rule_group_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the rule group under which the rule is stored.",
+						},
+						"trigger": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the rule fires: `store-journal`, `update-journal` or `manual-activation`.",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether or not the rule is active.",
+						},
+						"strict": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "If strict, ALL triggers must match for the rule to fire. Otherwise, just one is enough.",
+						},
+						"stop_processing": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "If true and the rule is triggered, other rules after this one in the group are skipped.",
+						},
+						"triggers": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The triggers that determine when the rule fires.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The type of trigger.",
+									},
+									"value": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The value to match against.",
+									},
+									"active": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Whether this trigger is active.",
+									},
+									"prohibited": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "If true, the trigger is negated.",
+									},
+									"stop_processing": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "If true, other triggers are not checked after this one fires.",
+									},
+								},
+							},
+						},
+						"actions": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The actions to perform when the rule fires.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The type of action.",
+									},
+									"value": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The value for the action.",
+									},
+									"active": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Whether this action is active.",
+									},
+									"stop_processing": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "If true, other actions do not fire after this one.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(d.client, &data.UserGroupID)
+
+	var rules []client.Rule
+	var err error
+	if data.RuleGroupID.IsNull() {
+		rules, err = apiClient.ListRules(ctx)
+	} else {
+		rules, err = apiClient.ListRuleGroupRules(ctx, data.RuleGroupID.ValueString())
+	}
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("rule_group_id"), "Rule Group Not Found", fmt.Sprintf("No rule group with ID %s exists.", data.RuleGroupID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rules, got error: %s", err))
+		return
+	}
+
+	ruleValues := make([]attr.Value, len(rules))
+	for i, rule := range rules {
+		ruleValues[i], _ = types.ObjectValue(ruleAttrTypes, map[string]attr.Value{
+			"id":              types.StringValue(rule.ID),
+			"title":           types.StringValue(rule.Title),
+			"description":     types.StringValue(rule.Description),
+			"rule_group_id":   types.StringValue(rule.RuleGroupID),
+			"trigger":         types.StringValue(rule.Trigger),
+			"active":          types.BoolValue(rule.Active),
+			"strict":          types.BoolValue(rule.Strict),
+			"stop_processing": types.BoolValue(rule.StopProcessing),
+			"triggers":        ruleTriggersValue(rule.Triggers),
+			"actions":         ruleActionsValue(rule.Actions),
+		})
+	}
+
+	data.Rules, _ = types.ListValue(types.ObjectType{AttrTypes: ruleAttrTypes}, ruleValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRulesDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig(name) + `
+data "firefly3_rules" "test" {
+  rule_group_id = firefly3_rule.test.rule_group_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly3_rules.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firefly3_rules.test", "rules.0.id", "firefly3_rule.test", "id"),
+					resource.TestCheckResourceAttr("data.firefly3_rules.test", "rules.0.title", name+" supermarket"),
+				),
+			},
+		},
+	})
+}
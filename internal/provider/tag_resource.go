@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &TagResource{}
+var _ resource.ResourceWithImportState = &TagResource{}
+
+func NewTagResource() resource.Resource {
+	return &TagResource{}
+}
+
+type TagResource struct {
+	client *client.Client
+}
+
+type TagResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	UserGroupID types.String  `tfsdk:"user_group_id"`
+	Tag         types.String  `tfsdk:"tag"`
+	Date        types.String  `tfsdk:"date"`
+	Description types.String  `tfsdk:"description"`
+	Latitude    types.Float64 `tfsdk:"latitude"`
+	Longitude   types.Float64 `tfsdk:"longitude"`
+	ZoomLevel   types.Int32   `tfsdk:"zoom_level"`
+}
+
+func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III tag. Tags are labels that can be attached to transactions, for example by the `add_tag` rule action.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the tag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Tag/StoreRequest.php
+			"tag": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the tag. Must be at most 1024 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A date associated with the tag, formatted as `YYYY-MM-DD`.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the tag.",
+			},
+			"latitude": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Latitude of the tag's location. Requires `longitude` and `zoom_level`.",
+				Validators: []validator.Float64{
+					float64validator.Between(-90, 90),
+					float64validator.AlsoRequires(path.MatchRoot("longitude"), path.MatchRoot("zoom_level")),
+				},
+			},
+			"longitude": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Longitude of the tag's location. Requires `latitude` and `zoom_level`.",
+				Validators: []validator.Float64{
+					float64validator.Between(-180, 180),
+					float64validator.AlsoRequires(path.MatchRoot("latitude"), path.MatchRoot("zoom_level")),
+				},
+			},
+			"zoom_level": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: "Zoom level of the map showing the tag's location. Requires `latitude` and `longitude`.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+					int32validator.AlsoRequires(path.MatchRoot("latitude"), path.MatchRoot("longitude")),
+				},
+			},
+		},
+	}
+}
+
+func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	tag := r.modelToAPITag(&data)
+
+	createdTag, err := apiClient.CreateTag(ctx, tag)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag, got error: %s", err))
+		return
+	}
+
+	r.apiTagToModel(createdTag, &data)
+
+	tflog.Trace(ctx, "created a tag resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	// After an import by name the ID holds the tag name; the API resolves
+	// both and the numeric ID is stored from the response.
+	tag, err := apiClient.GetTag(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Tag not found", fmt.Sprintf("Tag %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag, got error: %s", err))
+		return
+	}
+
+	r.apiTagToModel(tag, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	tag := r.modelToAPITag(&data)
+
+	updatedTag, err := apiClient.UpdateTag(ctx, data.ID.ValueString(), tag)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tag, got error: %s", err))
+		return
+	}
+
+	r.apiTagToModel(updatedTag, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteTag(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts either the ID or the name of the tag. Read resolves the
+// name to the numeric ID.
+func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *TagResource) modelToAPITag(data *TagResourceModel) *client.Tag {
+	tag := &client.Tag{
+		Tag:         data.Tag.ValueString(),
+		Date:        data.Date.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+	}
+
+	if !data.Latitude.IsNull() && !data.Longitude.IsNull() && !data.ZoomLevel.IsNull() {
+		tag.Latitude = data.Latitude.ValueFloat64Pointer()
+		tag.Longitude = data.Longitude.ValueFloat64Pointer()
+		tag.ZoomLevel = data.ZoomLevel.ValueInt32Pointer()
+	}
+
+	return tag
+}
+
+func (r *TagResource) apiTagToModel(tag *client.Tag, data *TagResourceModel) {
+	data.ID = types.StringValue(tag.ID)
+	data.Tag = types.StringValue(tag.Tag)
+	data.Date = dateValue(stringValueOf(tag.Date), data.Date)
+	data.Description = optionalStringValue(tag.Description, data.Description)
+	data.Latitude = types.Float64PointerValue(tag.Latitude)
+	data.Longitude = types.Float64PointerValue(tag.Longitude)
+	data.ZoomLevel = types.Int32PointerValue(tag.ZoomLevel)
+}
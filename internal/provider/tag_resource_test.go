@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourceConfig(name, "Expenses paid on behalf of someone else"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_tag.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_tag.test", "tag", name),
+					resource.TestCheckResourceAttr("firefly3_tag.test", "date", "2026-07-12"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTagResourceConfig(name, "Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_tag.test", "description", "Updated"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "firefly3_tag" "test" {
+  tag = %q
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("firefly3_tag.test", "date"),
+					resource.TestCheckNoResourceAttr("firefly3_tag.test", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagResourceConfig(tag, description string) string {
+	return fmt.Sprintf(`
+resource "firefly3_tag" "test" {
+  tag         = %q
+  description = %q
+  date        = "2026-07-12"
+}
+`, tag, description)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &TransactionLinkResource{}
+var _ resource.ResourceWithImportState = &TransactionLinkResource{}
+
+func NewTransactionLinkResource() resource.Resource {
+	return &TransactionLinkResource{}
+}
+
+type TransactionLinkResource struct {
+	client *client.Client
+}
+
+type TransactionLinkResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserGroupID types.String `tfsdk:"user_group_id"`
+	LinkTypeID  types.String `tfsdk:"link_type_id"`
+	InwardID    types.String `tfsdk:"inward_id"`
+	OutwardID   types.String `tfsdk:"outward_id"`
+	Notes       types.String `tfsdk:"notes"`
+}
+
+func (r *TransactionLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction_link"
+}
+
+func (r *TransactionLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a link between two Firefly III transactions, for example between a refund and the original purchase.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the transaction link.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			"link_type_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the link type that describes how the transactions are linked.",
+			},
+			"inward_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the inward transaction journal, i.e. the `transaction_journal_id` of a split. Changing this forces a new link.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"outward_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the outward transaction journal, i.e. the `transaction_journal_id` of a split. Changing this forces a new link.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes for the transaction link.",
+			},
+		},
+	}
+}
+
+func (r *TransactionLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TransactionLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TransactionLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionLink := r.modelToAPITransactionLink(&data)
+
+	createdTransactionLink, err := apiClient.CreateTransactionLink(ctx, transactionLink)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create transaction link, got error: %s", err))
+		return
+	}
+
+	r.apiTransactionLinkToModel(createdTransactionLink, &data)
+
+	tflog.Trace(ctx, "created a transaction link resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TransactionLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TransactionLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionLink, err := apiClient.GetTransactionLink(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Transaction link not found", fmt.Sprintf("Transaction link %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read transaction link, got error: %s", err))
+		return
+	}
+
+	r.apiTransactionLinkToModel(transactionLink, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TransactionLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TransactionLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionLink := r.modelToAPITransactionLink(&data)
+
+	updatedTransactionLink, err := apiClient.UpdateTransactionLink(ctx, data.ID.ValueString(), transactionLink)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update transaction link, got error: %s", err))
+		return
+	}
+
+	r.apiTransactionLinkToModel(updatedTransactionLink, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TransactionLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TransactionLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteTransactionLink(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete transaction link, got error: %s", err))
+		return
+	}
+}
+
+func (r *TransactionLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *TransactionLinkResource) modelToAPITransactionLink(data *TransactionLinkResourceModel) *client.TransactionLink {
+	return &client.TransactionLink{
+		LinkTypeID: data.LinkTypeID.ValueString(),
+		InwardID:   data.InwardID.ValueString(),
+		OutwardID:  data.OutwardID.ValueString(),
+		Notes:      data.Notes.ValueString(),
+	}
+}
+
+func (r *TransactionLinkResource) apiTransactionLinkToModel(transactionLink *client.TransactionLink, data *TransactionLinkResourceModel) {
+	data.ID = types.StringValue(transactionLink.ID)
+	data.LinkTypeID = types.StringValue(transactionLink.LinkTypeID)
+	data.InwardID = types.StringValue(transactionLink.InwardID)
+	data.OutwardID = types.StringValue(transactionLink.OutwardID)
+	data.Notes = optionalStringValue(transactionLink.Notes, data.Notes)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransactionLinkResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionLinkResourceConfig(name, "Returned within 30 days"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_transaction_link.test", "id"),
+					resource.TestCheckResourceAttrPair("firefly3_transaction_link.test", "link_type_id", "firefly3_link_type.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_transaction_link.test", "notes", "Returned within 30 days"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_transaction_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransactionLinkResourceConfig(name, "Refunded"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_transaction_link.test", "notes", "Refunded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransactionLinkResourceConfig(name, notes string) string {
+	return testAccAssetAccountConfig(name) + testAccLinkTypeResourceConfig(name, "refunds") + fmt.Sprintf(`
+resource "firefly3_transaction" "purchase" {
+  transactions = [
+    {
+      type           = "withdrawal"
+      date           = "2026-01-10"
+      amount         = "120"
+      description    = "%[1]s purchase"
+      source_id      = firefly3_account.asset.id
+      destination_id = firefly3_account.expense.id
+    },
+  ]
+}
+
+resource "firefly3_transaction" "refund" {
+  transactions = [
+    {
+      type           = "deposit"
+      date           = "2026-01-20"
+      amount         = "120"
+      description    = "%[1]s refund"
+      source_name    = "%[1]s revenue"
+      destination_id = firefly3_account.asset.id
+    },
+  ]
+}
+
+resource "firefly3_transaction_link" "test" {
+  link_type_id = firefly3_link_type.test.id
+  inward_id    = firefly3_transaction.purchase.transactions[0].transaction_journal_id
+  outward_id   = firefly3_transaction.refund.transactions[0].transaction_journal_id
+  notes        = %[2]q
+}
+`, name, notes)
+}
@@ -0,0 +1,647 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &TransactionResource{}
+var _ resource.ResourceWithImportState = &TransactionResource{}
+var _ resource.ResourceWithValidateConfig = &TransactionResource{}
+var _ resource.ResourceWithModifyPlan = &TransactionResource{}
+
+// configuredCategoriesKey is the private state key that records which splits
+// had a configured category when they were last applied.
+const configuredCategoriesKey = "configured_categories"
+
+// privateStateSetter is implemented by the private state of responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// transactionDateRegex matches a YYYY-MM-DD date or an RFC 3339 timestamp.
+var transactionDateRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2}))?$`)
+
+func NewTransactionResource() resource.Resource {
+	return &TransactionResource{}
+}
+
+type TransactionResource struct {
+	client *client.Client
+}
+
+type TransactionResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	UserGroupID  types.String `tfsdk:"user_group_id"`
+	GroupTitle   types.String `tfsdk:"group_title"`
+	ApplyRules   types.Bool   `tfsdk:"apply_rules"`
+	Transactions types.List   `tfsdk:"transactions"`
+}
+
+type TransactionSplitModel struct {
+	TransactionJournalID types.String `tfsdk:"transaction_journal_id"`
+	Type                 types.String `tfsdk:"type"`
+	Date                 types.String `tfsdk:"date"`
+	Amount               types.String `tfsdk:"amount"`
+	Description          types.String `tfsdk:"description"`
+	CurrencyCode         types.String `tfsdk:"currency_code"`
+	ForeignAmount        types.String `tfsdk:"foreign_amount"`
+	ForeignCurrencyCode  types.String `tfsdk:"foreign_currency_code"`
+	SourceID             types.String `tfsdk:"source_id"`
+	SourceName           types.String `tfsdk:"source_name"`
+	DestinationID        types.String `tfsdk:"destination_id"`
+	DestinationName      types.String `tfsdk:"destination_name"`
+	CategoryID           types.String `tfsdk:"category_id"`
+	CategoryName         types.String `tfsdk:"category_name"`
+	BudgetID             types.String `tfsdk:"budget_id"`
+	Tags                 types.Set    `tfsdk:"tags"`
+	Notes                types.String `tfsdk:"notes"`
+}
+
+var transactionSplitAttrTypes = map[string]attr.Type{
+	"transaction_journal_id": types.StringType,
+	"type":                   types.StringType,
+	"date":                   types.StringType,
+	"amount":                 types.StringType,
+	"description":            types.StringType,
+	"currency_code":          types.StringType,
+	"foreign_amount":         types.StringType,
+	"foreign_currency_code":  types.StringType,
+	"source_id":              types.StringType,
+	"source_name":            types.StringType,
+	"destination_id":         types.StringType,
+	"destination_name":       types.StringType,
+	"category_id":            types.StringType,
+	"category_name":          types.StringType,
+	"budget_id":              types.StringType,
+	"tags":                   types.SetType{ElemType: types.StringType},
+	"notes":                  types.StringType,
+}
+
+func (r *TransactionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transaction"
+}
+
+func (r *TransactionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III transaction. A transaction consists of one or more splits, which Firefly III calls a transaction group.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the transaction group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Transaction/StoreRequest.php
+			"group_title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The title of the transaction group. Required when there is more than one split. Must be at most 1000 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			"apply_rules": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether or not to apply the rules of the user when the transaction is created or updated. Changes made by rules show up as differences in the next plan. Defaults to `false`.",
+			},
+			"transactions": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "List of splits of the transaction. All splits must have the same `type`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"transaction_journal_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the split.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of the transaction. Must be one of: `withdrawal`, `deposit` or `transfer`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("withdrawal", "deposit", "transfer"),
+							},
+						},
+						"date": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The date of the transaction, formatted as `YYYY-MM-DD` or as an RFC 3339 timestamp such as `2026-01-31T12:00:00+01:00`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(transactionDateRegex, "must be formatted as YYYY-MM-DD or as an RFC 3339 timestamp"),
+							},
+						},
+						"amount": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The amount of the transaction, as a positive decimal string.",
+						},
+						"description": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The description of the transaction. Must be at most 1000 characters.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(1000),
+							},
+						},
+						"currency_code": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "The currency code of the amount (e.g., `EUR`). Defaults to the currency of the source account.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"foreign_amount": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The amount in a foreign currency, as a decimal string. Requires `foreign_currency_code`.",
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("foreign_currency_code")),
+							},
+						},
+						"foreign_currency_code": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The currency code of the foreign amount. Requires `foreign_amount`.",
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("foreign_amount")),
+							},
+						},
+						"source_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "ID of the source account. Conflicts with `source_name`.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source_name")),
+							},
+						},
+						"source_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Name of the source account. For deposits, a revenue account with this name is created if it does not exist yet. Conflicts with `source_id`.",
+						},
+						"destination_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "ID of the destination account. Conflicts with `destination_name`.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("destination_name")),
+							},
+						},
+						"destination_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Name of the destination account. For withdrawals, an expense account with this name is created if it does not exist yet. Conflicts with `destination_id`.",
+						},
+						"category_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "ID of the category of the transaction. Conflicts with `category_name`.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("category_name")),
+							},
+						},
+						"category_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Name of the category of the transaction. The category is created if it does not exist yet. Conflicts with `category_id`.",
+						},
+						"budget_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "ID of the budget of the transaction. Only applies to withdrawals.",
+						},
+						"tags": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Tags of the transaction. Tags that do not exist yet are created.",
+						},
+						"notes": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Notes for the transaction.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TransactionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TransactionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Transactions.IsUnknown() || data.Transactions.IsNull() {
+		return
+	}
+
+	var splits []TransactionSplitModel
+	resp.Diagnostics.Append(data.Transactions.ElementsAs(ctx, &splits, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(splits) > 1 && data.GroupTitle.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_title"),
+			"Missing Group Title",
+			"group_title is required when a transaction has more than one split.",
+		)
+	}
+
+	for i, split := range splits {
+		if split.Type.IsUnknown() || splits[0].Type.IsUnknown() {
+			continue
+		}
+
+		if !split.Type.Equal(splits[0].Type) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transactions").AtListIndex(i).AtName("type"),
+				"Mixed Transaction Types",
+				fmt.Sprintf("All splits of a transaction must have the same type, got %q and %q.", splits[0].Type.ValueString(), split.Type.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans the computed account and category references of every
+// split, which can be configured either by ID or by name, see
+// planSplitReferences.
+func (r *TransactionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || config.Transactions.IsUnknown() || plan.Transactions.IsUnknown() {
+		return
+	}
+
+	var configSplits, planSplits, stateSplits []TransactionSplitModel
+	resp.Diagnostics.Append(config.Transactions.ElementsAs(ctx, &configSplits, false)...)
+	resp.Diagnostics.Append(plan.Transactions.ElementsAs(ctx, &planSplits, false)...)
+	if !state.Transactions.IsNull() {
+		resp.Diagnostics.Append(state.Transactions.ElementsAs(ctx, &stateSplits, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configuredCategories []bool
+	value, diags := req.Private.GetKey(ctx, configuredCategoriesKey)
+	resp.Diagnostics.Append(diags...)
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &configuredCategories); err != nil {
+			resp.Diagnostics.AddError("Invalid Private State", fmt.Sprintf("Unable to read the configured categories, got error: %s", err))
+			return
+		}
+	}
+
+	for i := range planSplits {
+		if i >= len(configSplits) {
+			break
+		}
+
+		s := TransactionSplitModel{
+			SourceID:        types.StringUnknown(),
+			SourceName:      types.StringUnknown(),
+			DestinationID:   types.StringUnknown(),
+			DestinationName: types.StringUnknown(),
+			CategoryID:      types.StringUnknown(),
+			CategoryName:    types.StringUnknown(),
+		}
+		if i < len(stateSplits) {
+			s = stateSplits[i]
+		}
+
+		planSplitReferences(configSplits[i], s, &planSplits[i], i < len(configuredCategories) && configuredCategories[i])
+	}
+
+	transactions, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: transactionSplitAttrTypes}, planSplits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("transactions"), transactions)...)
+}
+
+// planSplitReferences plans the account and category references of split p
+// from its configuration c and its state s. An unconfigured category keeps
+// its current value, such as a category set by a rule, unless it was
+// configured before: removing it from the configuration clears it.
+func planSplitReferences(c, s TransactionSplitModel, p *TransactionSplitModel, categoryConfigured bool) {
+	var ok bool
+
+	if p.SourceID, p.SourceName, ok = planReference(c.SourceID, c.SourceName, s.SourceID, s.SourceName); !ok {
+		p.SourceID, p.SourceName = s.SourceID, s.SourceName
+	}
+	if p.DestinationID, p.DestinationName, ok = planReference(c.DestinationID, c.DestinationName, s.DestinationID, s.DestinationName); !ok {
+		p.DestinationID, p.DestinationName = s.DestinationID, s.DestinationName
+	}
+	if p.CategoryID, p.CategoryName, ok = planReference(c.CategoryID, c.CategoryName, s.CategoryID, s.CategoryName); !ok {
+		p.CategoryID, p.CategoryName = s.CategoryID, s.CategoryName
+		if categoryConfigured {
+			p.CategoryID, p.CategoryName = types.StringNull(), types.StringNull()
+		}
+	}
+}
+
+// setConfiguredCategories records in private state which splits of config
+// have a configured category, see planSplitReferences.
+func setConfiguredCategories(ctx context.Context, config tfsdk.Config, private privateStateSetter) diag.Diagnostics {
+	var data TransactionResourceModel
+	var splits []TransactionSplitModel
+
+	diags := config.Get(ctx, &data)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(data.Transactions.ElementsAs(ctx, &splits, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	configured := make([]bool, len(splits))
+	for i, split := range splits {
+		configured[i] = !split.CategoryID.IsNull() || !split.CategoryName.IsNull()
+	}
+
+	value, err := json.Marshal(configured)
+	if err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to store the configured categories, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, configuredCategoriesKey, value)...)
+	return diags
+}
+
+func (r *TransactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionGroup, diags := r.modelToAPITransaction(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdTransactionGroup, err := apiClient.CreateTransaction(ctx, transactionGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiTransactionToModel(ctx, createdTransactionGroup, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a transaction resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredCategories(ctx, req.Config, resp.Private)...)
+}
+
+func (r *TransactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TransactionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionGroup, err := apiClient.GetTransaction(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Transaction not found", fmt.Sprintf("Transaction %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiTransactionToModel(ctx, transactionGroup, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TransactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TransactionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	transactionGroup, diags := r.modelToAPITransaction(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedTransactionGroup, err := apiClient.UpdateTransaction(ctx, data.ID.ValueString(), transactionGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update transaction, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apiTransactionToModel(ctx, updatedTransactionGroup, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredCategories(ctx, req.Config, resp.Private)...)
+}
+
+func (r *TransactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TransactionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteTransaction(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete transaction, got error: %s", err))
+		return
+	}
+}
+
+func (r *TransactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *TransactionResource) modelToAPITransaction(ctx context.Context, data *TransactionResourceModel) (*client.TransactionGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transactionGroup := &client.TransactionGroup{
+		GroupTitle: data.GroupTitle.ValueString(),
+		ApplyRules: data.ApplyRules.ValueBool(),
+	}
+
+	var splitModels []TransactionSplitModel
+	diags.Append(data.Transactions.ElementsAs(ctx, &splitModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	transactionGroup.Transactions = make([]client.TransactionSplit, len(splitModels))
+	for i, t := range splitModels {
+		split := client.TransactionSplit{
+			TransactionJournalID: knownString(t.TransactionJournalID),
+			Type:                 t.Type.ValueString(),
+			Date:                 t.Date.ValueString(),
+			Amount:               t.Amount.ValueString(),
+			Description:          t.Description.ValueString(),
+			CurrencyCode:         knownString(t.CurrencyCode),
+			ForeignAmount:        t.ForeignAmount.ValueString(),
+			ForeignCurrencyCode:  t.ForeignCurrencyCode.ValueString(),
+			SourceID:             knownString(t.SourceID),
+			SourceName:           knownString(t.SourceName),
+			DestinationID:        knownString(t.DestinationID),
+			DestinationName:      knownString(t.DestinationName),
+			CategoryID:           knownString(t.CategoryID),
+			CategoryName:         knownString(t.CategoryName),
+			BudgetID:             t.BudgetID.ValueString(),
+			Tags:                 []string{},
+			Notes:                t.Notes.ValueString(),
+		}
+
+		if !t.Tags.IsNull() && !t.Tags.IsUnknown() {
+			diags.Append(t.Tags.ElementsAs(ctx, &split.Tags, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		transactionGroup.Transactions[i] = split
+	}
+
+	return transactionGroup, diags
+}
+
+func (r *TransactionResource) apiTransactionToModel(ctx context.Context, transactionGroup *client.TransactionGroup, data *TransactionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(transactionGroup.ID)
+	data.GroupTitle = optionalStringValue(transactionGroup.GroupTitle, data.GroupTitle)
+	if data.ApplyRules.IsNull() {
+		data.ApplyRules = types.BoolValue(false)
+	}
+
+	// The prior values are used to keep unset optional attributes null and
+	// configured amounts and dates in their original notation.
+	var priorSplits []TransactionSplitModel
+	if !data.Transactions.IsNull() && !data.Transactions.IsUnknown() {
+		diags.Append(data.Transactions.ElementsAs(ctx, &priorSplits, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	splitValues := make([]attr.Value, len(transactionGroup.Transactions))
+	for i, t := range transactionGroup.Transactions {
+		var prior TransactionSplitModel
+		if i < len(priorSplits) {
+			prior = priorSplits[i]
+		}
+
+		tags := types.SetNull(types.StringType)
+		if len(t.Tags) > 0 || (!prior.Tags.IsNull() && !prior.Tags.IsUnknown()) {
+			var d diag.Diagnostics
+			tags, d = types.SetValueFrom(ctx, types.StringType, append([]string{}, t.Tags...))
+			diags.Append(d...)
+		}
+
+		splitValues[i], _ = types.ObjectValue(transactionSplitAttrTypes, map[string]attr.Value{
+			"transaction_journal_id": types.StringValue(t.TransactionJournalID),
+			"type":                   types.StringValue(t.Type),
+			"date":                   dateTimeValue(t.Date, prior.Date),
+			"amount":                 decimalValue(t.Amount, prior.Amount),
+			"description":            types.StringValue(t.Description),
+			"currency_code":          types.StringValue(t.CurrencyCode),
+			"foreign_amount":         decimalValue(t.ForeignAmount, prior.ForeignAmount),
+			"foreign_currency_code":  optionalStringValue(t.ForeignCurrencyCode, prior.ForeignCurrencyCode),
+			"source_id":              nullableStringValue(t.SourceID),
+			"source_name":            nullableStringValue(t.SourceName),
+			"destination_id":         nullableStringValue(t.DestinationID),
+			"destination_name":       nullableStringValue(t.DestinationName),
+			"category_id":            nullableStringValue(t.CategoryID),
+			"category_name":          nullableStringValue(t.CategoryName),
+			"budget_id":              optionalStringValue(t.BudgetID, prior.BudgetID),
+			"tags":                   tags,
+			"notes":                  optionalStringValue(t.Notes, prior.Notes),
+		})
+	}
+	data.Transactions, _ = types.ListValue(types.ObjectType{AttrTypes: transactionSplitAttrTypes}, splitValues)
+
+	return diags
+}
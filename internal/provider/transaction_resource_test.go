@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPlanSplitReferences(t *testing.T) {
+	null := types.StringNull()
+	state := TransactionSplitModel{
+		SourceID:        types.StringValue("1"),
+		SourceName:      types.StringValue("Checking"),
+		DestinationID:   types.StringValue("2"),
+		DestinationName: types.StringValue("Supermarket"),
+		CategoryID:      types.StringValue("3"),
+		CategoryName:    types.StringValue("Groceries"),
+	}
+
+	tests := map[string]struct {
+		config             TransactionSplitModel
+		categoryConfigured bool
+		wantCategoryID     types.String
+		wantCategoryName   types.String
+	}{
+		"category by name": {
+			config:         TransactionSplitModel{SourceID: types.StringValue("1"), DestinationName: types.StringValue("Supermarket"), CategoryName: types.StringValue("Groceries")},
+			wantCategoryID: types.StringValue("3"), wantCategoryName: types.StringValue("Groceries"),
+		},
+		"category set by Firefly III": {
+			config:         TransactionSplitModel{SourceID: types.StringValue("1"), DestinationName: types.StringValue("Supermarket"), CategoryID: null, CategoryName: null},
+			wantCategoryID: types.StringValue("3"), wantCategoryName: types.StringValue("Groceries"),
+		},
+		"configured category removed": {
+			config:             TransactionSplitModel{SourceID: types.StringValue("1"), DestinationName: types.StringValue("Supermarket"), CategoryID: null, CategoryName: null},
+			categoryConfigured: true,
+			wantCategoryID:     null, wantCategoryName: null,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var plan TransactionSplitModel
+			planSplitReferences(test.config, state, &plan, test.categoryConfigured)
+
+			if !plan.SourceID.Equal(state.SourceID) || !plan.SourceName.Equal(state.SourceName) {
+				t.Errorf("source = (%s, %s), want the source from state", plan.SourceID, plan.SourceName)
+			}
+			if !plan.DestinationID.Equal(state.DestinationID) || !plan.DestinationName.Equal(state.DestinationName) {
+				t.Errorf("destination = (%s, %s), want the destination from state", plan.DestinationID, plan.DestinationName)
+			}
+			if !plan.CategoryID.Equal(test.wantCategoryID) || !plan.CategoryName.Equal(test.wantCategoryName) {
+				t.Errorf("category = (%s, %s), want (%s, %s)", plan.CategoryID, plan.CategoryName, test.wantCategoryID, test.wantCategoryName)
+			}
+		})
+	}
+}
+
+func TestTransactionResourceModifyPlan(t *testing.T) {
+	transaction := func(t *testing.T, split TransactionSplitModel) *TransactionResourceModel {
+		split.Type = types.StringValue("withdrawal")
+		split.Date = types.StringValue("2026-01-10")
+		split.Amount = types.StringValue("54.20")
+		split.Description = types.StringValue("Groceries")
+		split.Tags = types.SetNull(types.StringType)
+
+		splits, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: transactionSplitAttrTypes}, []TransactionSplitModel{split})
+		if diags.HasError() {
+			t.Fatalf("unable to build splits: %v", diags)
+		}
+
+		return &TransactionResourceModel{ID: types.StringValue("12"), ApplyRules: types.BoolValue(false), Transactions: splits}
+	}
+	unknown := types.StringUnknown()
+
+	t.Run("create", func(t *testing.T) {
+		config := transaction(t, TransactionSplitModel{SourceID: types.StringValue("1"), DestinationName: types.StringValue("Supermarket")})
+		plan := transaction(t, TransactionSplitModel{SourceID: types.StringValue("1"), SourceName: unknown, DestinationID: unknown, DestinationName: types.StringValue("Supermarket"), CategoryID: unknown, CategoryName: unknown})
+
+		got, diags := testModifyPlan(t, &TransactionResource{}, config, nil, plan)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		var splits []TransactionSplitModel
+		got.Transactions.ElementsAs(context.Background(), &splits, false)
+		if !splits[0].SourceName.IsUnknown() || !splits[0].DestinationID.IsUnknown() || !splits[0].CategoryID.IsUnknown() {
+			t.Errorf("split = %+v, want unknown computed references", splits[0])
+		}
+	})
+
+	t.Run("changed destination", func(t *testing.T) {
+		state := transaction(t, TransactionSplitModel{
+			TransactionJournalID: types.StringValue("20"),
+			SourceID:             types.StringValue("1"), SourceName: types.StringValue("Checking"),
+			DestinationID: types.StringValue("2"), DestinationName: types.StringValue("Supermarket"),
+			CategoryID: types.StringValue("3"), CategoryName: types.StringValue("Groceries"),
+		})
+		config := transaction(t, TransactionSplitModel{SourceID: types.StringValue("1"), DestinationName: types.StringValue("Bakery")})
+		plan := transaction(t, TransactionSplitModel{
+			TransactionJournalID: types.StringValue("20"),
+			SourceID:             types.StringValue("1"), SourceName: unknown,
+			DestinationID: unknown, DestinationName: types.StringValue("Bakery"),
+			CategoryID: unknown, CategoryName: unknown,
+		})
+
+		got, diags := testModifyPlan(t, &TransactionResource{}, config, state, plan)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		var splits []TransactionSplitModel
+		got.Transactions.ElementsAs(context.Background(), &splits, false)
+		if !splits[0].SourceName.Equal(types.StringValue("Checking")) {
+			t.Errorf("source_name = %s, want the name from state", splits[0].SourceName)
+		}
+		if !splits[0].DestinationID.IsUnknown() {
+			t.Errorf("destination_id = %s, want unknown", splits[0].DestinationID)
+		}
+		if !splits[0].CategoryName.Equal(types.StringValue("Groceries")) {
+			t.Errorf("category_name = %s, want the category from state", splits[0].CategoryName)
+		}
+	})
+}
+
+func TestAccTransactionResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionResourceConfig(name, "2026-01-10", "54.20", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_transaction.test", "id"),
+					resource.TestCheckResourceAttrSet("firefly3_transaction.test", "transactions.0.transaction_journal_id"),
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.date", "2026-01-10"),
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.amount", "54.20"),
+					resource.TestCheckResourceAttrPair("firefly3_transaction.test", "transactions.0.destination_id", "firefly3_account.expense", "id"),
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.category_name", name),
+					resource.TestCheckResourceAttrSet("firefly3_transaction.test", "transactions.0.category_id"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_transaction.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransactionResourceConfig(name, "2026-01-11T12:30:00Z", "60", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.date", "2026-01-11T12:30:00Z"),
+					resource.TestCheckResourceAttr("firefly3_transaction.test", "transactions.0.amount", "60"),
+				),
+			},
+			{
+				Config: testAccTransactionResourceConfig(name, "2026-01-11T12:30:00Z", "60", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("firefly3_transaction.test", "transactions.0.category_id"),
+					resource.TestCheckNoResourceAttr("firefly3_transaction.test", "transactions.0.category_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransactionResourceConfig(name, date, amount string, withCategory bool) string {
+	category := ""
+	if withCategory {
+		category = fmt.Sprintf("category_name  = %q", name)
+	}
+
+	return testAccAssetAccountConfig(name) + fmt.Sprintf(`
+resource "firefly3_transaction" "test" {
+  transactions = [
+    {
+      type           = "withdrawal"
+      date           = %[2]q
+      amount         = %[3]q
+      description    = "%[1]s groceries"
+      source_id      = firefly3_account.asset.id
+      destination_id = firefly3_account.expense.id
+      tags           = ["%[1]s-b", "%[1]s-a"]
+      %[4]s
+    },
+  ]
+}
+`, name, date, amount, category)
+}
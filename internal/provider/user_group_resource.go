@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &UserGroupResource{}
+var _ resource.ResourceWithImportState = &UserGroupResource{}
+
+func NewUserGroupResource() resource.Resource {
+	return &UserGroupResource{}
+}
+
+type UserGroupResource struct {
+	client *client.Client
+}
+
+type UserGroupResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Title               types.String `tfsdk:"title"`
+	PrimaryCurrencyCode types.String `tfsdk:"primary_currency_code"`
+	InUse               types.Bool   `tfsdk:"in_use"`
+}
+
+func (r *UserGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+func (r *UserGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III user group, also called a financial administration. " +
+			"The Firefly III API cannot create or delete user groups, so this resource manages an existing group with the same title.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user group. Use it as `user_group_id` of the provider or of other resources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title of the user group. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"primary_currency_code": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The code of the primary currency of the user group (e.g., `EUR`).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 51),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_use": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user group contains any financial data.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adopts the existing user group with the configured title. The
+// Firefly III API has no endpoint to create user groups.
+func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroups, err := r.client.ListUserGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list user groups, got error: %s", err))
+		return
+	}
+
+	var existingUserGroup *client.UserGroup
+	for i := range userGroups {
+		if userGroups[i].Title == data.Title.ValueString() {
+			existingUserGroup = &userGroups[i]
+			break
+		}
+	}
+
+	if existingUserGroup == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"User Group Not Found",
+			fmt.Sprintf("No user group with title %q exists for this user. Create the financial administration in Firefly III first.", data.Title.ValueString()),
+		)
+		return
+	}
+
+	userGroup := r.modelToAPIUserGroup(&data, existingUserGroup)
+
+	createdUserGroup, err := r.client.UpdateUserGroup(ctx, existingUserGroup.ID, userGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user group, got error: %s", err))
+		return
+	}
+
+	r.apiUserGroupToModel(createdUserGroup, &data)
+
+	tflog.Trace(ctx, "created a user group resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroup, err := r.client.GetUserGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("User group not found", fmt.Sprintf("User group %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user group, got error: %s", err))
+		return
+	}
+
+	r.apiUserGroupToModel(userGroup, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroup := r.modelToAPIUserGroup(&data, &client.UserGroup{PrimaryCurrencyCode: state.PrimaryCurrencyCode.ValueString()})
+
+	updatedUserGroup, err := r.client.UpdateUserGroup(ctx, data.ID.ValueString(), userGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user group, got error: %s", err))
+		return
+	}
+
+	r.apiUserGroupToModel(updatedUserGroup, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the user group from the Terraform state, because the
+// Firefly III API cannot delete user groups. The user group and everything
+// in it remain in Firefly III.
+func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "user groups cannot be deleted, removing from state only", map[string]any{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.AddWarning(
+		"User group not deleted",
+		fmt.Sprintf("User group %q cannot be deleted through the Firefly III API. It has only been removed from the Terraform state and still exists in Firefly III.", data.Title.ValueString()),
+	)
+}
+
+func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// modelToAPIUserGroup converts the model into an API user group. The primary
+// currency of existing is kept when none is configured.
+func (r *UserGroupResource) modelToAPIUserGroup(data *UserGroupResourceModel, existing *client.UserGroup) *client.UserGroup {
+	userGroup := &client.UserGroup{
+		Title:               data.Title.ValueString(),
+		PrimaryCurrencyCode: existing.PrimaryCurrencyCode,
+	}
+
+	if !data.PrimaryCurrencyCode.IsNull() && !data.PrimaryCurrencyCode.IsUnknown() {
+		userGroup.PrimaryCurrencyCode = data.PrimaryCurrencyCode.ValueString()
+	}
+
+	return userGroup
+}
+
+func (r *UserGroupResource) apiUserGroupToModel(userGroup *client.UserGroup, data *UserGroupResourceModel) {
+	data.ID = types.StringValue(userGroup.ID)
+	data.Title = types.StringValue(userGroup.Title)
+	data.PrimaryCurrencyCode = nullableStringValue(userGroup.PrimaryCurrencyCode)
+	data.InUse = types.BoolValue(userGroup.InUse)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+func TestModelToAPIUserGroup(t *testing.T) {
+	existing := &client.UserGroup{ID: "1", Title: "Household", PrimaryCurrencyCode: "EUR"}
+
+	tests := map[string]struct {
+		currencyCode types.String
+		want         string
+	}{
+		"configured":     {currencyCode: types.StringValue("USD"), want: "USD"},
+		"not configured": {currencyCode: types.StringNull(), want: "EUR"},
+		"unknown":        {currencyCode: types.StringUnknown(), want: "EUR"},
+	}
+
+	r := &UserGroupResource{}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := &UserGroupResourceModel{Title: types.StringValue("Household"), PrimaryCurrencyCode: test.currencyCode}
+
+			got := r.modelToAPIUserGroup(data, existing)
+			if got.Title != "Household" || got.PrimaryCurrencyCode != test.want {
+				t.Errorf("modelToAPIUserGroup() = %+v, want title Household and currency %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAccUserGroupResource(t *testing.T) {
+	title := os.Getenv("FIREFLY3_TEST_USER_GROUP_TITLE")
+	if title == "" {
+		t.Skip("FIREFLY3_TEST_USER_GROUP_TITLE must be set to the title of an existing user group")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupResourceConfig(title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_user_group.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_user_group.test", "title", title),
+					resource.TestCheckResourceAttrSet("firefly3_user_group.test", "primary_currency_code"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_user_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUserGroupResource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserGroupResourceConfig(acctest.RandomWithPrefix("tf-acc")),
+				ExpectError: regexp.MustCompile("User Group Not Found"),
+			},
+		},
+	})
+}
+
+func testAccUserGroupResourceConfig(title string) string {
+	return fmt.Sprintf(`
+resource "firefly3_user_group" "test" {
+  title = %q
+}
+`, title)
+}
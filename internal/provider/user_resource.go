@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *client.Client
+}
+
+type UserResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Blocked     types.Bool   `tfsdk:"blocked"`
+	BlockedCode types.String `tfsdk:"blocked_code"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user of a Firefly III instance. Managing users requires an `api_key` of a user with the owner role.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/System/UserStoreRequest.php
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the user, which is also the login name. Must be unique and at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The role of the user. Must be `owner` or `demo`. Leave empty for a regular user.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("owner", "demo"),
+				},
+			},
+			"blocked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether or not the user is blocked from logging in. Defaults to `false`.",
+			},
+			"blocked_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The reason the user is blocked. Must be `email_changed`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("email_changed"),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := r.modelToAPIUser(&data)
+
+	createdUser, err := r.client.CreateUser(ctx, user)
+	if err != nil {
+		resp.Diagnostics.Append(ownerRequiredError("create", "user", err)...)
+		return
+	}
+
+	r.apiUserToModel(createdUser, &data)
+
+	tflog.Trace(ctx, "created a user resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("User not found", fmt.Sprintf("User %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(ownerRequiredError("read", "user", err)...)
+		return
+	}
+
+	r.apiUserToModel(user, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := r.modelToAPIUser(&data)
+
+	updatedUser, err := r.client.UpdateUser(ctx, data.ID.ValueString(), user)
+	if err != nil {
+		resp.Diagnostics.Append(ownerRequiredError("update", "user", err)...)
+		return
+	}
+
+	r.apiUserToModel(updatedUser, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(ownerRequiredError("delete", "user", err)...)
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *UserResource) modelToAPIUser(data *UserResourceModel) *client.User {
+	return &client.User{
+		Email:       data.Email.ValueString(),
+		Role:        data.Role.ValueString(),
+		Blocked:     data.Blocked.ValueBool(),
+		BlockedCode: data.BlockedCode.ValueString(),
+	}
+}
+
+func (r *UserResource) apiUserToModel(user *client.User, data *UserResourceModel) {
+	data.ID = types.StringValue(user.ID)
+	data.Email = types.StringValue(user.Email)
+	data.Role = optionalStringValue(user.Role, data.Role)
+	data.Blocked = types.BoolValue(user.Blocked)
+	data.BlockedCode = optionalStringValue(user.BlockedCode, data.BlockedCode)
+}
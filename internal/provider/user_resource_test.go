@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	email := acctest.RandomWithPrefix("tf-acc") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(email, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_user.test", "id"),
+					resource.TestCheckResourceAttr("firefly3_user.test", "email", email),
+					resource.TestCheckResourceAttr("firefly3_user.test", "blocked", "false"),
+				),
+			},
+			{
+				ResourceName:      "firefly3_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserResourceConfig(email, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_user.test", "blocked", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(email string, blocked bool) string {
+	return fmt.Sprintf(`
+resource "firefly3_user" "test" {
+  email   = %q
+  blocked = %t
+}
+`, email, blocked)
+}
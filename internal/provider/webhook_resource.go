@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/renescheepers/terraform-provider-firefly3/internal/client"
+)
+
+// Interface guards
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+type WebhookResource struct {
+	client *client.Client
+}
+
+type WebhookResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	UserGroupID             types.String `tfsdk:"user_group_id"`
+	Title                   types.String `tfsdk:"title"`
+	URL                     types.String `tfsdk:"url"`
+	Trigger                 types.String `tfsdk:"trigger"`
+	Response                types.String `tfsdk:"response"`
+	Delivery                types.String `tfsdk:"delivery"`
+	Active                  types.Bool   `tfsdk:"active"`
+	Secret                  types.String `tfsdk:"secret"`
+	RegenerateSecretTrigger types.String `tfsdk:"regenerate_secret_trigger"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Firefly III webhook. Webhooks send a message to a URL when transactions are created, updated or deleted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the webhook.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_group_id": userGroupIDAttribute(),
+			// Max length: https://github.com/firefly-iii/firefly-iii/blob/067112904e06a988ffb0ef83d36112e4adea6a68/app/Api/V1/Requests/Models/Webhook/CreateRequest.php
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The title of the webhook. Must be at most 255 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL the message is sent to. Must be at most 1024 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The event that triggers the webhook. Must be one of: `STORE_TRANSACTION`, `UPDATE_TRANSACTION` or `DESTROY_TRANSACTION`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("STORE_TRANSACTION", "UPDATE_TRANSACTION", "DESTROY_TRANSACTION"),
+				},
+			},
+			"response": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The content of the message. Must be one of: `TRANSACTIONS`, `ACCOUNTS` or `NONE`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("TRANSACTIONS", "ACCOUNTS", "NONE"),
+				},
+			},
+			"delivery": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("JSON"),
+				MarkdownDescription: "The format of the message. Must be `JSON`. Defaults to `JSON`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("JSON"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether or not the webhook is active. Defaults to `true`.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret Firefly III uses to sign messages. Generated by the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"regenerate_secret_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An arbitrary value that makes Firefly III generate a new `secret` whenever it changes.",
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan marks the secret as unknown when it is going to be regenerated.
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if regenerateSecret(&state, &plan) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), types.StringUnknown())...)
+	}
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	webhook := r.modelToAPIWebhook(&data)
+
+	createdWebhook, err := apiClient.CreateWebhook(ctx, webhook)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
+		return
+	}
+
+	r.apiWebhookToModel(createdWebhook, &data)
+
+	tflog.Trace(ctx, "created a webhook resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	webhook, err := apiClient.GetWebhook(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning("Webhook not found", fmt.Sprintf("Webhook %s not found", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
+		return
+	}
+
+	r.apiWebhookToModel(webhook, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	webhook := r.modelToAPIWebhook(&data)
+
+	updatedWebhook, err := apiClient.UpdateWebhook(ctx, data.ID.ValueString(), webhook, regenerateSecret(&state, &data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
+		return
+	}
+
+	r.apiWebhookToModel(updatedWebhook, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := userGroupClient(r.client, &data.UserGroupID)
+
+	err := apiClient.DeleteWebhook(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s", err))
+		return
+	}
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = importUserGroupID(ctx, req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// regenerateSecret reports whether the plan changes regenerate_secret_trigger
+// to a new value. Removing the trigger does not regenerate the secret.
+func regenerateSecret(state, plan *WebhookResourceModel) bool {
+	return !plan.RegenerateSecretTrigger.IsNull() && !plan.RegenerateSecretTrigger.Equal(state.RegenerateSecretTrigger)
+}
+
+func (r *WebhookResource) modelToAPIWebhook(data *WebhookResourceModel) *client.Webhook {
+	return &client.Webhook{
+		Title:    data.Title.ValueString(),
+		URL:      data.URL.ValueString(),
+		Trigger:  data.Trigger.ValueString(),
+		Response: data.Response.ValueString(),
+		Delivery: data.Delivery.ValueString(),
+		Active:   data.Active.ValueBool(),
+	}
+}
+
+func (r *WebhookResource) apiWebhookToModel(webhook *client.Webhook, data *WebhookResourceModel) {
+	data.ID = types.StringValue(webhook.ID)
+	data.Title = types.StringValue(webhook.Title)
+	data.URL = types.StringValue(webhook.URL)
+	data.Trigger = types.StringValue(webhook.Trigger)
+	data.Response = types.StringValue(webhook.Response)
+	data.Delivery = types.StringValue(webhook.Delivery)
+	data.Active = types.BoolValue(webhook.Active)
+	data.Secret = types.StringValue(webhook.Secret)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWebhookResourceModifyPlan(t *testing.T) {
+	webhook := func(secret, trigger types.String) *WebhookResourceModel {
+		return &WebhookResourceModel{
+			ID:                      types.StringValue("3"),
+			UserGroupID:             types.StringNull(),
+			Title:                   types.StringValue("Budget alerts"),
+			URL:                     types.StringValue("https://example.com/hooks/firefly"),
+			Trigger:                 types.StringValue("STORE_TRANSACTION"),
+			Response:                types.StringValue("TRANSACTIONS"),
+			Delivery:                types.StringValue("JSON"),
+			Active:                  types.BoolValue(true),
+			Secret:                  secret,
+			RegenerateSecretTrigger: trigger,
+		}
+	}
+	secret := types.StringValue("s3cr3t")
+
+	tests := map[string]struct {
+		stateTrigger, planTrigger types.String
+		wantSecret                types.String
+	}{
+		"unchanged trigger": {stateTrigger: types.StringValue("2026-01"), planTrigger: types.StringValue("2026-01"), wantSecret: secret},
+		"changed trigger":   {stateTrigger: types.StringValue("2026-01"), planTrigger: types.StringValue("2026-02"), wantSecret: types.StringUnknown()},
+		"added trigger":     {stateTrigger: types.StringNull(), planTrigger: types.StringValue("2026-01"), wantSecret: types.StringUnknown()},
+		"removed trigger":   {stateTrigger: types.StringValue("2026-01"), planTrigger: types.StringNull(), wantSecret: secret},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := webhook(types.StringNull(), test.planTrigger)
+			got, diags := testModifyPlan(t, &WebhookResource{}, config, webhook(secret, test.stateTrigger), webhook(secret, test.planTrigger))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Secret.Equal(test.wantSecret) {
+				t.Errorf("secret = %s, want %s", got.Secret, test.wantSecret)
+			}
+		})
+	}
+}
+
+func TestAccWebhookResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfig(name, "2026-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly3_webhook.test", "id"),
+					resource.TestCheckResourceAttrSet("firefly3_webhook.test", "secret"),
+					resource.TestCheckResourceAttr("firefly3_webhook.test", "title", name),
+				),
+			},
+			{
+				ResourceName:            "firefly3_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"regenerate_secret_trigger"},
+			},
+			{
+				Config: testAccWebhookResourceConfig(name, "2026-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly3_webhook.test", "regenerate_secret_trigger", "2026-02"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceConfig(name, regenerateSecretTrigger string) string {
+	return fmt.Sprintf(`
+resource "firefly3_webhook" "test" {
+  title    = %q
+  url      = "https://example.com/hooks/firefly"
+  trigger  = "STORE_TRANSACTION"
+  response = "TRANSACTIONS"
+
+  regenerate_secret_trigger = %q
+}
+`, name, regenerateSecretTrigger)
+}